@@ -16,9 +16,18 @@ package runner
 
 import (
 	"sigs.k8s.io/crdify/pkg/validations"
+	"sigs.k8s.io/crdify/pkg/validations/crd/celcost"
+	"sigs.k8s.io/crdify/pkg/validations/crd/conversion"
+	"sigs.k8s.io/crdify/pkg/validations/crd/defaultvalidity"
 	"sigs.k8s.io/crdify/pkg/validations/crd/existingfieldremoval"
+	"sigs.k8s.io/crdify/pkg/validations/crd/names"
+	"sigs.k8s.io/crdify/pkg/validations/crd/preservedfieldaddition"
+	"sigs.k8s.io/crdify/pkg/validations/crd/printercolumns"
 	"sigs.k8s.io/crdify/pkg/validations/crd/scope"
 	"sigs.k8s.io/crdify/pkg/validations/crd/storedversionremoval"
+	"sigs.k8s.io/crdify/pkg/validations/crd/subresources"
+	"sigs.k8s.io/crdify/pkg/validations/crd/versionlifecycle"
+	"sigs.k8s.io/crdify/pkg/validations/crd/versionremoval"
 	"sigs.k8s.io/crdify/pkg/validations/property"
 )
 
@@ -28,7 +37,16 @@ var defaultRegistry = validations.NewRegistry()
 func init() {
 	existingfieldremoval.Register(defaultRegistry)
 	scope.Register(defaultRegistry)
+	names.Register(defaultRegistry)
 	storedversionremoval.Register(defaultRegistry)
+	versionlifecycle.Register(defaultRegistry)
+	versionremoval.Register(defaultRegistry)
+	subresources.Register(defaultRegistry)
+	printercolumns.Register(defaultRegistry)
+	conversion.Register(defaultRegistry)
+	celcost.Register(defaultRegistry)
+	preservedfieldaddition.Register(defaultRegistry)
+	defaultvalidity.Register(defaultRegistry)
 	property.RegisterDefault(defaultRegistry)
 	property.RegisterEnum(defaultRegistry)
 	property.RegisterMaximum(defaultRegistry)
@@ -39,11 +57,26 @@ func init() {
 	property.RegisterMinItems(defaultRegistry)
 	property.RegisterMinLength(defaultRegistry)
 	property.RegisterMinProperties(defaultRegistry)
+	property.RegisterMultipleOf(defaultRegistry)
 	property.RegisterRequired(defaultRegistry)
 	property.RegisterType(defaultRegistry)
 	property.RegisterDescription(defaultRegistry)
+	property.RegisterDocumentation(defaultRegistry)
 	property.RegisterPattern(defaultRegistry)
 	property.RegisterNullable(defaultRegistry)
+	property.RegisterXValidations(defaultRegistry)
+	property.RegisterFormat(defaultRegistry)
+	property.RegisterListType(defaultRegistry)
+	property.RegisterPreserveUnknownFields(defaultRegistry)
+	property.RegisterXIntOrString(defaultRegistry)
+	property.RegisterXEmbeddedResource(defaultRegistry)
+	property.RegisterUniqueItems(defaultRegistry)
+	property.RegisterAdditionalProperties(defaultRegistry)
+	property.RegisterAdditionalItems(defaultRegistry)
+	property.RegisterTupleItems(defaultRegistry)
+	property.RegisterComposition(defaultRegistry)
+	property.RegisterPatternProperties(defaultRegistry)
+	property.RegisterDependencies(defaultRegistry)
 }
 
 // DefaultRegistry returns a pre-configured validations.Registry.
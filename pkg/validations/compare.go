@@ -17,10 +17,13 @@ package validations
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/google/go-cmp/cmp"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/crdify/pkg/config"
 )
 
@@ -51,21 +54,82 @@ func (pcr PropertyComparisonResult) IsZero() bool {
 
 // CompareVersions calculates the diff in the provided old and new CustomResourceDefinitionVersions and
 // compares the differing properties using the provided comparators.
+// Comparators are provided the flattened schemas of a differing property, which include its children schemas,
+// so that they are able to inspect them (i.e the items of a list). Changes to the children schemas are
+// evaluated as properties of their own. Lists whose items schema changed are also compared when a comparator
+// finds an issue with the change from the point of view of the list. The schemas of pattern properties that exist under the same pattern
+// in the old and new version are evaluated in the same way, with their path including the pattern
+// (i.e ^.spec.patternProperties[^a+$].foo).
 // An 'unhandled' comparator will be injected to evaluate any unhandled changes by the provided comparators
 // that will be enforced based on the provided unhandled enforcement policy.
 // Returns a map[string][]ComparisonResult, where the map key is the flattened property path (i.e ^.spec.foo.bar).
 func CompareVersions(a, b apiextensionsv1.CustomResourceDefinitionVersion, unhandledEnforcement config.EnforcementPolicy, comparators ...Comparator[apiextensionsv1.JSONSchemaProps]) []PropertyComparisonResult {
-	oldFlattened := FlattenCRDVersion(a)
-	newFlattened := FlattenCRDVersion(b)
+	return compareFlattened(FlattenCRDVersion(a), FlattenCRDVersion(b), unhandledEnforcement, comparators...)
+}
 
+// compareFlattened compares the differing properties of the provided old and new flattened schemas
+// using the provided comparators, along with the pattern properties they share.
+func compareFlattened(oldFlattened, newFlattened map[string]*apiextensionsv1.JSONSchemaProps, unhandledEnforcement config.EnforcementPolicy, comparators ...Comparator[apiextensionsv1.JSONSchemaProps]) []PropertyComparisonResult {
 	diffs := FlattenedCRDVersionDiff(oldFlattened, newFlattened)
 
 	result := []PropertyComparisonResult{}
 
-	for property, diff := range diffs {
+	for property := range diffs {
+		// In the event the property no longer exists on the new version
+		// compare against an empty schema
+		newSchema, ok := newFlattened[property]
+		if !ok {
+			newSchema = &apiextensionsv1.JSONSchemaProps{}
+		}
+
 		result = append(result, PropertyComparisonResult{
 			Property:          property,
-			ComparisonResults: CompareProperties(diff.Old, diff.New, unhandledEnforcement, comparators...),
+			ComparisonResults: CompareProperties(oldFlattened[property], newSchema, unhandledEnforcement, comparators...),
+		})
+	}
+
+	result = append(result, compareListsWithChangedItems(oldFlattened, newFlattened, diffs, unhandledEnforcement, comparators...)...)
+
+	for property, diff := range SharedPatternProperties(oldFlattened, newFlattened) {
+		root := field.NewPath(property)
+		result = append(result, compareFlattened(FlattenSchema(diff.Old, root), FlattenSchema(diff.New, root), unhandledEnforcement, comparators...)...)
+	}
+
+	return result
+}
+
+// compareListsWithChangedItems compares the lists that did not change themselves but whose items schema did,
+// so that comparators that inspect the items of a list (i.e whether list map keys are still required)
+// are able to evaluate the change from the point of view of the list.
+// Lists are only included when a comparator found an issue with the change.
+func compareListsWithChangedItems(oldFlattened, newFlattened map[string]*apiextensionsv1.JSONSchemaProps, diffs map[string]Diff, unhandledEnforcement config.EnforcementPolicy, comparators ...Comparator[apiextensionsv1.JSONSchemaProps]) []PropertyComparisonResult {
+	result := []PropertyComparisonResult{}
+
+	for property := range diffs {
+		list, ok := strings.CutSuffix(property, ".items")
+		if !ok {
+			continue
+		}
+
+		if _, changed := diffs[list]; changed {
+			continue
+		}
+
+		oldSchema, oldOk := oldFlattened[list]
+		newSchema, newOk := newFlattened[list]
+
+		if !oldOk || !newOk {
+			continue
+		}
+
+		comparisonResults := CompareProperties(oldSchema, newSchema, unhandledEnforcement, comparators...)
+		if !slices.ContainsFunc(comparisonResults, func(cr ComparisonResult) bool { return !cr.IsZero() }) {
+			continue
+		}
+
+		result = append(result, PropertyComparisonResult{
+			Property:          list,
+			ComparisonResults: comparisonResults,
 		})
 	}
 
@@ -75,6 +139,8 @@ func CompareVersions(a, b apiextensionsv1.CustomResourceDefinitionVersion, unhan
 // CompareProperties compares the provided JSONSchemaProps using the provided comparators.
 // An 'unhandled' comparator will be injected to evaluate any unhandled changes by the provided
 // comparators that will be enforced based on the provided unhandled enforcement policy.
+// Changes to the children schemas are not considered when evaluating unhandled changes
+// as they are evaluated as properties of their own.
 // Returns a slice containing all the comparison results.
 func CompareProperties(a, b *apiextensionsv1.JSONSchemaProps, unhandledEnforcement config.EnforcementPolicy, comparators ...Comparator[apiextensionsv1.JSONSchemaProps]) []ComparisonResult {
 	result := []ComparisonResult{}
@@ -86,7 +152,7 @@ func CompareProperties(a, b *apiextensionsv1.JSONSchemaProps, unhandledEnforceme
 	}
 
 	// checking for unhandled changes is _always_ performed last.
-	result = append(result, checkUnhandled(aCopy, bCopy, unhandledEnforcement))
+	result = append(result, checkUnhandled(DropChildrenPropertiesFromJSONSchema(aCopy), DropChildrenPropertiesFromJSONSchema(bCopy), unhandledEnforcement))
 
 	return result
 }
@@ -0,0 +1,272 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validations
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/crdify/pkg/config"
+)
+
+// childCountComparator flags properties where the number of child properties changed.
+type childCountComparator struct{}
+
+func (childCountComparator) Compare(a, b *apiextensionsv1.JSONSchemaProps) ComparisonResult {
+	var err error
+	if len(a.Properties) != len(b.Properties) {
+		err = errors.New("child properties changed")
+	}
+
+	return HandleErrors("childCount", config.EnforcementPolicyError, err)
+}
+
+// itemsRequiredComparator flags lists where the required fields of the items changed.
+type itemsRequiredComparator struct{}
+
+func (itemsRequiredComparator) Compare(a, b *apiextensionsv1.JSONSchemaProps) ComparisonResult {
+	var err error
+	if a.Items != nil && b.Items != nil && len(a.Items.Schema.Required) != len(b.Items.Schema.Required) {
+		err = errors.New("items required changed")
+	}
+
+	return HandleErrors("itemsRequired", config.EnforcementPolicyError, err)
+}
+
+// resettingComparator resets the type of the compared properties.
+type resettingComparator struct{}
+
+func (resettingComparator) Compare(a, b *apiextensionsv1.JSONSchemaProps) ComparisonResult {
+	a.Type = ""
+	b.Type = ""
+
+	return HandleErrors("resetting", config.EnforcementPolicyError)
+}
+
+// resettingDescriptionComparator resets the description of the compared properties.
+type resettingDescriptionComparator struct{}
+
+func (resettingDescriptionComparator) Compare(a, b *apiextensionsv1.JSONSchemaProps) ComparisonResult {
+	a.Description = ""
+	b.Description = ""
+
+	return HandleErrors("resettingDescription", config.EnforcementPolicyError)
+}
+
+func TestCompareProperties(t *testing.T) {
+	a := &apiextensionsv1.JSONSchemaProps{Type: "integer", Format: "int32"}
+	b := &apiextensionsv1.JSONSchemaProps{Type: "number", Format: "double"}
+
+	results := CompareProperties(a, b, config.EnforcementPolicyError, resettingComparator{})
+
+	for _, result := range results {
+		switch result.Name {
+		case "unhandled":
+			assert.Len(t, result.Errors, 1)
+			assert.Contains(t, result.Errors[0], "int32", "fields that were not reset should be unhandled changes")
+			assert.NotContains(t, result.Errors[0], "integer", "fields that were reset should not be unhandled changes")
+		default:
+			assert.Empty(t, result.Errors)
+		}
+	}
+
+	assert.Equal(t, "integer", a.Type, "the provided schemas should not be modified")
+}
+
+func versionWithSpec(spec apiextensionsv1.JSONSchemaProps) apiextensionsv1.CustomResourceDefinitionVersion {
+	return apiextensionsv1.CustomResourceDefinitionVersion{
+		Name: "v1",
+		Schema: &apiextensionsv1.CustomResourceValidation{
+			OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+				Type: "object",
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{
+					"spec": spec,
+				},
+			},
+		},
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	oldVersion := versionWithSpec(apiextensionsv1.JSONSchemaProps{
+		Type: "object",
+		Properties: map[string]apiextensionsv1.JSONSchemaProps{
+			"foo": {Type: "string"},
+		},
+	})
+
+	t.Run("child changes are not unhandled changes of the parent", func(t *testing.T) {
+		newVersion := versionWithSpec(apiextensionsv1.JSONSchemaProps{
+			Type: "object",
+			Properties: map[string]apiextensionsv1.JSONSchemaProps{
+				"foo": {Type: "integer"},
+			},
+		})
+
+		results := CompareVersions(oldVersion, newVersion, config.EnforcementPolicyError, childCountComparator{})
+
+		assert.Len(t, results, 1)
+		assert.Equal(t, "^.spec.foo", results[0].Property)
+	})
+
+	t.Run("parents are not compared when only their children changed", func(t *testing.T) {
+		newVersion := versionWithSpec(apiextensionsv1.JSONSchemaProps{
+			Type: "object",
+			Properties: map[string]apiextensionsv1.JSONSchemaProps{
+				"foo": {Type: "string"},
+				"bar": {Type: "string"},
+			},
+		})
+
+		results := CompareVersions(oldVersion, newVersion, config.EnforcementPolicyError, childCountComparator{})
+
+		assert.Empty(t, results)
+	})
+
+	t.Run("lists are compared when their items changed and a comparator found an issue", func(t *testing.T) {
+		listVersion := func(description string, required ...string) apiextensionsv1.CustomResourceDefinitionVersion {
+			return versionWithSpec(apiextensionsv1.JSONSchemaProps{
+				Type: "array",
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+					Schema: &apiextensionsv1.JSONSchemaProps{
+						Type:        "object",
+						Description: description,
+						Required:    required,
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"name": {Type: "string"},
+						},
+					},
+				},
+			})
+		}
+
+		results := CompareVersions(listVersion("", "name"), listVersion(""), config.EnforcementPolicyNone, itemsRequiredComparator{})
+
+		properties := []string{}
+		for _, result := range results {
+			properties = append(properties, result.Property)
+		}
+
+		assert.ElementsMatch(t, []string{"^.spec", "^.spec.items"}, properties)
+
+		results = CompareVersions(listVersion("", "name"), listVersion("changed", "name"), config.EnforcementPolicyNone, itemsRequiredComparator{})
+
+		assert.Len(t, results, 1)
+		assert.Equal(t, "^.spec.items", results[0].Property)
+	})
+
+	t.Run("comparators are provided children schemas of changed properties", func(t *testing.T) {
+		newVersion := versionWithSpec(apiextensionsv1.JSONSchemaProps{
+			Type:        "object",
+			Description: "changed",
+			Properties: map[string]apiextensionsv1.JSONSchemaProps{
+				"foo": {Type: "string"},
+				"bar": {Type: "string"},
+			},
+		})
+
+		results := CompareVersions(oldVersion, newVersion, config.EnforcementPolicyNone, childCountComparator{})
+
+		assert.Len(t, results, 1)
+		assert.Equal(t, "^.spec", results[0].Property)
+
+		for _, result := range results[0].ComparisonResults {
+			if result.Name == "childCount" {
+				assert.Len(t, result.Errors, 1)
+			}
+		}
+	})
+
+	t.Run("additionalProperties schema changes are not unhandled changes of the parent", func(t *testing.T) {
+		mapVersion := func(valueType string) apiextensionsv1.CustomResourceDefinitionVersion {
+			return versionWithSpec(apiextensionsv1.JSONSchemaProps{
+				Type: "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{
+					Allows: true,
+					Schema: &apiextensionsv1.JSONSchemaProps{Type: valueType},
+				},
+			})
+		}
+
+		results := CompareVersions(mapVersion("string"), mapVersion("integer"), config.EnforcementPolicyError)
+
+		assert.Len(t, results, 1)
+		assert.Equal(t, "^.spec.additionalProperties", results[0].Property)
+	})
+
+	t.Run("composition branches are not compared by index", func(t *testing.T) {
+		withAllOf := func(branches ...apiextensionsv1.JSONSchemaProps) apiextensionsv1.CustomResourceDefinitionVersion {
+			return versionWithSpec(apiextensionsv1.JSONSchemaProps{
+				Type:  "object",
+				AllOf: branches,
+			})
+		}
+
+		first := apiextensionsv1.JSONSchemaProps{Required: []string{"foo"}}
+		second := apiextensionsv1.JSONSchemaProps{Required: []string{"bar"}}
+
+		results := CompareVersions(withAllOf(first, second), withAllOf(second, first), config.EnforcementPolicyError)
+
+		assert.Len(t, results, 1)
+		assert.Equal(t, "^.spec", results[0].Property)
+	})
+
+	t.Run("pattern properties are not compared by pattern", func(t *testing.T) {
+		withPatternProperty := func(pattern string) apiextensionsv1.CustomResourceDefinitionVersion {
+			return versionWithSpec(apiextensionsv1.JSONSchemaProps{
+				Type: "object",
+				PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{
+					pattern: {Type: "object", Properties: map[string]apiextensionsv1.JSONSchemaProps{"foo": {Type: "string"}}},
+				},
+			})
+		}
+
+		results := CompareVersions(withPatternProperty("^a+$"), withPatternProperty("^(a)+$"), config.EnforcementPolicyError)
+
+		assert.Len(t, results, 1)
+		assert.Equal(t, "^.spec", results[0].Property)
+	})
+
+	t.Run("pattern properties sharing a pattern are compared as properties of their own", func(t *testing.T) {
+		withPatternProperty := func(description, fooType string) apiextensionsv1.CustomResourceDefinitionVersion {
+			return versionWithSpec(apiextensionsv1.JSONSchemaProps{
+				Type: "object",
+				PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{
+					"^a+$": {
+						Type:        "object",
+						Description: description,
+						Properties:  map[string]apiextensionsv1.JSONSchemaProps{"foo": {Type: fooType}},
+					},
+				},
+			})
+		}
+
+		results := CompareVersions(withPatternProperty("old", "string"), withPatternProperty("old", "integer"), config.EnforcementPolicyError)
+
+		assert.Len(t, results, 1)
+		assert.Equal(t, "^.spec.patternProperties[^a+$].foo", results[0].Property)
+
+		results = CompareVersions(withPatternProperty("old", "string"), withPatternProperty("new", "string"), config.EnforcementPolicyError, resettingDescriptionComparator{})
+
+		assert.Len(t, results, 1)
+		assert.Equal(t, "^.spec.patternProperties[^a+$]", results[0].Property)
+
+		for _, result := range results[0].ComparisonResults {
+			assert.Empty(t, result.Errors, "changes handled by a comparator should not be unhandled changes")
+		}
+	})
+}
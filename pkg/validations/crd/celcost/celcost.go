@@ -0,0 +1,423 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package celcost
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsvalidation "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/validation"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	apiextensionscel "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	"k8s.io/apiserver/pkg/cel/environment"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+	"sigs.k8s.io/crdify/pkg/validations/property"
+)
+
+var (
+	_ validations.Validation                                           = (*CELCost)(nil)
+	_ validations.Comparator[apiextensionsv1.CustomResourceDefinition] = (*CELCost)(nil)
+)
+
+const (
+	name = "celCost"
+
+	// defaultIncreaseThresholdPercent is the IncreaseThresholdPercent
+	// used when none is configured.
+	defaultIncreaseThresholdPercent = 10
+)
+
+// Register registers the CELCost validation
+// with the provided validation registry.
+func Register(registry validations.Registry) {
+	registry.Register(name, factory)
+}
+
+// factory is a function used to initialize a CELCost validation
+// implementation based on the provided configuration.
+func factory(cfg map[string]interface{}) (validations.Validation, error) {
+	celCostCfg := &CELCostConfig{}
+
+	err := property.ConfigToType(cfg, celCostCfg)
+	if err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	err = ValidateCELCostConfig(celCostCfg)
+	if err != nil {
+		return nil, fmt.Errorf("validating celCost config: %w", err)
+	}
+
+	return &CELCost{CELCostConfig: *celCostCfg}, nil
+}
+
+// ValidateCELCostConfig ensures provided CELCostConfig is valid.
+func ValidateCELCostConfig(in *CELCostConfig) error {
+	if in == nil {
+		return nil
+	}
+
+	if in.IncreaseThresholdPercent != nil && *in.IncreaseThresholdPercent < 0 {
+		return fmt.Errorf("%w : %d (must not be negative)", errInvalidIncreaseThresholdPercent, *in.IncreaseThresholdPercent)
+	}
+
+	return nil
+}
+
+var errInvalidIncreaseThresholdPercent = errors.New("invalid increaseThresholdPercent")
+
+// CELCostConfig contains the configuration options for the CELCost validation.
+type CELCostConfig struct {
+	// IncreaseThresholdPercent is the percentage by which the estimated cost of
+	// a rule, or the total estimated cost of a version, may grow before a warning
+	// is reported. Set to 0 to warn about any increase. Must not be negative.
+	// Defaults to 10.
+	IncreaseThresholdPercent *int `json:"increaseThresholdPercent,omitempty"`
+}
+
+// CELCost is a validations.Validation implementation
+// used to check the estimated cost of the x-kubernetes-validations
+// rules of a CRD against the budgets enforced by the API server
+// and for large increases in cost from one CRD instance to another.
+type CELCost struct {
+	CELCostConfig
+
+	// enforcement is the EnforcementPolicy that this validation
+	// should use when performing its validation logic
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the CELCost validation.
+func (cc *CELCost) Name() string {
+	return name
+}
+
+// SetEnforcement sets the EnforcementPolicy for the CELCost validation.
+func (cc *CELCost) SetEnforcement(enforcement config.EnforcementPolicy) {
+	cc.enforcement = enforcement
+}
+
+// Compare compares an old and a new CustomResourceDefintion, estimating the cost of every
+// x-kubernetes-validations rule in each version of both. Rules and versions of the new
+// CustomResourceDefinition that exceed the budgets enforced by the API server are reported
+// as errors. Rules and versions whose estimated cost grew by more than the configured
+// threshold since the old CustomResourceDefinition are reported as warnings.
+// The estimated cost of every other rule of the new CustomResourceDefinition that was added or
+// whose estimated cost changed, and the total estimated cost of every version of the new
+// CustomResourceDefinition with rules, are reported as warnings so that they can be reviewed.
+func (cc *CELCost) Compare(a, b *apiextensionsv1.CustomResourceDefinition) validations.ComparisonResult {
+	errs := []error{}
+	warns := []error{}
+
+	for _, newVersion := range b.Spec.Versions {
+		newCost := estimateVersionCost(newVersion)
+		errs = append(errs, newCost.errs...)
+
+		reported := map[expressionKey]bool{}
+
+		for _, expr := range newCost.expressions {
+			if expr.cost > apiextensionsvalidation.StaticEstimatedCostLimit {
+				errs = append(errs, fmt.Errorf("%w : %s %s : estimated cost %d exceeds budget %d",
+					ErrExpressionCostExceedsBudget, newVersion.Name, expr, expr.cost, apiextensionsvalidation.StaticEstimatedCostLimit))
+				reported[expr.expressionKey] = true
+			}
+		}
+
+		totalReported := false
+
+		if newCost.total > apiextensionsvalidation.StaticEstimatedCRDCostLimit {
+			errs = append(errs, fmt.Errorf("%w : %s : estimated cost %d exceeds budget %d",
+				ErrTotalCostExceedsBudget, newVersion.Name, newCost.total, apiextensionsvalidation.StaticEstimatedCRDCostLimit))
+			totalReported = true
+		}
+
+		oldCost := &versionCost{}
+		if oldVersion := validations.GetCRDVersionByName(a, newVersion.Name); oldVersion != nil {
+			oldCost = estimateVersionCost(*oldVersion)
+		}
+
+		warns = append(warns, cc.compareVersionCosts(newVersion.Name, oldCost, newCost, reported, totalReported)...)
+	}
+
+	return validations.HandleErrorsAndWarnings(cc.Name(), cc.enforcement, errs, warns)
+}
+
+// compareVersionCosts returns a warning for every expression, and the total, of a version whose estimated
+// cost grew by more than the configured threshold. The estimated cost of every other expression that was added
+// or whose estimated cost changed, and the total estimated cost of the version, are reported as well.
+// Expressions that are marked as reported, and the total when totalReported is set, are not reported again.
+func (cc *CELCost) compareVersionCosts(version string, oldCost, newCost *versionCost, reported map[expressionKey]bool, totalReported bool) []error {
+	warns := []error{}
+
+	oldExpressionCosts := map[expressionKey]uint64{}
+	for _, expr := range oldCost.expressions {
+		oldExpressionCosts[expr.expressionKey] = expr.cost
+	}
+
+	for _, expr := range newCost.expressions {
+		oldExprCost, ok := oldExpressionCosts[expr.expressionKey]
+		if !ok || reported[expr.expressionKey] {
+			continue
+		}
+
+		if increase, exceeded := cc.increase(oldExprCost, expr.cost); exceeded {
+			warns = append(warns, fmt.Errorf("%w : %s %s : estimated cost %d -> %d (+%.0f%%)",
+				ErrExpressionCostIncreased, version, expr, oldExprCost, expr.cost, increase))
+			reported[expr.expressionKey] = true
+		}
+	}
+
+	for _, expr := range newCost.expressions {
+		oldExprCost, ok := oldExpressionCosts[expr.expressionKey]
+
+		switch {
+		case reported[expr.expressionKey] || (ok && oldExprCost == expr.cost):
+			// already reported or unchanged
+		case !ok:
+			warns = append(warns, fmt.Errorf("%w : %s %s : estimated cost %d (budget %d)",
+				ErrExpressionCost, version, expr, expr.cost, apiextensionsvalidation.StaticEstimatedCostLimit))
+		default:
+			warns = append(warns, fmt.Errorf("%w : %s %s : estimated cost %d -> %d (budget %d)",
+				ErrExpressionCost, version, expr, oldExprCost, expr.cost, apiextensionsvalidation.StaticEstimatedCostLimit))
+		}
+	}
+
+	switch increase, exceeded := cc.increase(oldCost.total, newCost.total); {
+	case totalReported || len(newCost.expressions) == 0:
+		// already reported or no rules to report the cost of
+	case exceeded:
+		warns = append(warns, fmt.Errorf("%w : %s : estimated cost %d -> %d (+%.0f%%)",
+			ErrTotalCostIncreased, version, oldCost.total, newCost.total, increase))
+	default:
+		warns = append(warns, fmt.Errorf("%w : %s : estimated cost %d (budget %d)",
+			ErrTotalCost, version, newCost.total, apiextensionsvalidation.StaticEstimatedCRDCostLimit))
+	}
+
+	return warns
+}
+
+// increase returns the percentage by which the cost grew from older to newer
+// and whether that growth exceeds the configured threshold. Growth from a cost
+// of zero is never considered to exceed the threshold.
+func (cc *CELCost) increase(older, newer uint64) (float64, bool) {
+	if older == 0 || newer <= older {
+		return 0, false
+	}
+
+	increase := (float64(newer) - float64(older)) / float64(older) * 100
+
+	return increase, increase > float64(ptr.Deref(cc.IncreaseThresholdPercent, defaultIncreaseThresholdPercent))
+}
+
+var (
+	// ErrExpressionCostExceedsBudget represents an error state where the estimated cost of a
+	// CEL expression exceeds the per-expression budget enforced by the API server.
+	ErrExpressionCostExceedsBudget = errors.New("CEL expression estimated cost exceeds budget")
+
+	// ErrTotalCostExceedsBudget represents an error state where the total estimated cost of all CEL
+	// expressions in a version's schema exceeds the per-CRD budget enforced by the API server.
+	ErrTotalCostExceedsBudget = errors.New("CEL total estimated cost exceeds budget")
+
+	// ErrExpressionCostIncreased represents a state where the estimated cost of a CEL
+	// expression has increased by more than the configured threshold.
+	ErrExpressionCostIncreased = errors.New("CEL expression estimated cost increased")
+
+	// ErrTotalCostIncreased represents a state where the total estimated cost of all CEL expressions
+	// in a version's schema has increased by more than the configured threshold.
+	ErrTotalCostIncreased = errors.New("CEL total estimated cost increased")
+
+	// ErrExpressionCost represents a state where the estimated cost of an added or changed CEL expression
+	// is reported for review without exceeding the budget or increasing beyond the threshold.
+	ErrExpressionCost = errors.New("CEL expression estimated cost")
+
+	// ErrTotalCost represents a state where the total estimated cost of all CEL expressions in a version's
+	// schema is reported for review without exceeding the budget or increasing beyond the threshold.
+	ErrTotalCost = errors.New("CEL total estimated cost")
+
+	// ErrCostEstimation represents an error state where the cost of the CEL expressions
+	// in a version's schema could not be estimated, typically because a rule does not compile.
+	ErrCostEstimation = errors.New("unable to estimate CEL cost")
+)
+
+// expressionKey identifies a CEL expression within a version's schema.
+type expressionKey struct {
+	// path is the path of the property the expression is declared on (i.e ^.spec.foo)
+	path string
+
+	// field is the field of the validation rule the expression is set in,
+	// either "rule" or "messageExpression"
+	field string
+
+	// expression is the CEL expression
+	expression string
+}
+
+func (ek expressionKey) String() string {
+	return fmt.Sprintf("%s %s %q", ek.path, ek.field, ek.expression)
+}
+
+// expressionCost is the estimated cost of a single CEL expression.
+type expressionCost struct {
+	expressionKey
+
+	cost uint64
+}
+
+// versionCost is the estimated cost of all CEL expressions within a version's schema.
+type versionCost struct {
+	expressions []expressionCost
+
+	// total is the sum of the estimated costs of all expressions,
+	// calculated in the same way as the API server
+	total uint64
+
+	// errs are the errors encountered while estimating costs
+	errs []error
+}
+
+// estimateVersionCost estimates the cost of every x-kubernetes-validations rule and messageExpression
+// in the provided CustomResourceDefinitionVersion the same way the API server does when validating a
+// CustomResourceDefinition. Schemas that are not structural are not estimated.
+func estimateVersionCost(version apiextensionsv1.CustomResourceDefinitionVersion) *versionCost {
+	vc := &versionCost{}
+
+	if version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+		return vc
+	}
+
+	internalSchema := &apiextensions.JSONSchemaProps{}
+
+	err := apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(version.Schema.OpenAPIV3Schema, internalSchema, nil)
+	if err != nil {
+		vc.errs = append(vc.errs, fmt.Errorf("%w : %s : %w", ErrCostEstimation, version.Name, err))
+		return vc
+	}
+
+	// The API server only evaluates CEL rules for structural schemas
+	if _, err := structuralschema.NewStructural(internalSchema); err != nil {
+		return vc
+	}
+
+	vc.estimate(version.Name, internalSchema, "^", apiextensionsvalidation.RootCELContext(internalSchema))
+
+	return vc
+}
+
+// estimate recursively estimates the cost of the CEL expressions declared on schema and its children.
+func (vc *versionCost) estimate(version string, schema *apiextensions.JSONSchemaProps, path string, celContext *apiextensionsvalidation.CELSchemaContext) {
+	if schema == nil {
+		return
+	}
+
+	if len(schema.XValidations) > 0 {
+		vc.estimateRules(version, schema, path, celContext)
+	}
+
+	for propName := range schema.Properties {
+		propSchema := schema.Properties[propName]
+		vc.estimate(version, &propSchema, path+"."+propName, celContext.ChildPropertyContext(&propSchema, propName))
+	}
+
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+		vc.estimate(version, schema.AdditionalProperties.Schema, path+".additionalProperties", celContext.ChildAdditionalPropertiesContext(schema.AdditionalProperties.Schema))
+	}
+
+	if schema.Items != nil {
+		if schema.Items.Schema != nil {
+			vc.estimate(version, schema.Items.Schema, path+".items", celContext.ChildItemsContext(schema.Items.Schema))
+		}
+
+		for i := range schema.Items.JSONSchemas {
+			vc.estimate(version, &schema.Items.JSONSchemas[i], fmt.Sprintf("%s.items[%d]", path, i), celContext.ChildItemsContext(&schema.Items.JSONSchemas[i]))
+		}
+	}
+}
+
+// estimateRules estimates the cost of the CEL expressions declared directly on schema.
+func (vc *versionCost) estimateRules(version string, schema *apiextensions.JSONSchemaProps, path string, celContext *apiextensionsvalidation.CELSchemaContext) {
+	typeInfo, err := celContext.TypeInfo()
+	if err != nil {
+		vc.errs = append(vc.errs, fmt.Errorf("%w : %s %s : %w", ErrCostEstimation, version, path, err))
+		return
+	}
+
+	if typeInfo == nil {
+		return
+	}
+
+	results, err := apiextensionscel.Compile(typeInfo.Schema, typeInfo.DeclType, celconfig.PerCallLimit,
+		environment.MustBaseEnvSet(environment.DefaultCompatibilityVersion(), true), apiextensionscel.StoredExpressionsEnvLoader())
+	if err != nil {
+		vc.errs = append(vc.errs, fmt.Errorf("%w : %s %s : %w", ErrCostEstimation, version, path, err))
+		return
+	}
+
+	for i, result := range results {
+		rule := schema.XValidations[i]
+
+		if result.Error != nil {
+			vc.errs = append(vc.errs, fmt.Errorf("%w : %s %s rule %q : %s", ErrCostEstimation, version, path, rule.Rule, result.Error.Detail))
+			continue
+		}
+
+		vc.observe(expressionKey{path: path, field: "rule", expression: rule.Rule}, expressionCostWithCardinality(result, celContext))
+
+		if result.MessageExpressionError != nil {
+			vc.errs = append(vc.errs, fmt.Errorf("%w : %s %s messageExpression %q : %s", ErrCostEstimation, version, path, rule.MessageExpression, result.MessageExpressionError.Detail))
+			continue
+		}
+
+		if result.MessageExpression != nil {
+			vc.observe(expressionKey{path: path, field: "messageExpression", expression: rule.MessageExpression}, result.MessageExpressionMaxCost)
+		}
+	}
+}
+
+// observe records the cost of an expression and adds it to the total.
+func (vc *versionCost) observe(key expressionKey, cost uint64) {
+	vc.expressions = append(vc.expressions, expressionCost{expressionKey: key, cost: cost})
+
+	if math.MaxUint64-vc.total < cost {
+		vc.total = math.MaxUint64
+		return
+	}
+
+	vc.total += cost
+}
+
+// expressionCostWithCardinality returns the estimated cost of a rule multiplied by the maximum number
+// of times it can be evaluated, mirroring the calculation performed by the API server.
+func expressionCostWithCardinality(result apiextensionscel.CompilationResult, celContext *apiextensionsvalidation.CELSchemaContext) uint64 {
+	cardinality := result.MaxCardinality
+	if celContext.MaxCardinality != nil {
+		cardinality = *celContext.MaxCardinality
+	}
+
+	if result.MaxCost == 0 {
+		return 0
+	}
+
+	if math.MaxUint64/result.MaxCost < cardinality {
+		return math.MaxUint64
+	}
+
+	return result.MaxCost * cardinality
+}
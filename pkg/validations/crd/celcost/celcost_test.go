@@ -0,0 +1,695 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package celcost
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/crdify/pkg/config"
+	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
+)
+
+func TestCELCost(t *testing.T) {
+	testcases := []internaltesting.Testcase[apiextensionsv1.CustomResourceDefinition]{
+		{
+			Name: "no rules, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"foo": {
+											Type: "string",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"foo": {
+											Type: "string",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &CELCost{},
+		},
+		{
+			Name: "rule in unbounded list exceeds per rule budget, flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"foo": {
+											Type:     "array",
+											MaxItems: ptr.To[int64](10),
+											Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+												Schema: &apiextensionsv1.JSONSchemaProps{
+													Type:         "string",
+													XValidations: apiextensionsv1.ValidationRules{{Rule: "self.matches('^a+$')"}},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"foo": {
+											Type: "array",
+											Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+												Schema: &apiextensionsv1.JSONSchemaProps{
+													Type:         "string",
+													XValidations: apiextensionsv1.ValidationRules{{Rule: "self.matches('^a+$')"}},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &CELCost{},
+		},
+		{
+			Name: "quadratic rule on unbounded list exceeds per rule budget, flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"foo": {
+											Type: "string",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"foo": {
+											Type: "array",
+											Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+												Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"},
+											},
+											XValidations: apiextensionsv1.ValidationRules{{Rule: "self.all(x, self.all(y, x == y))"}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &CELCost{},
+		},
+		{
+			Name: "rule fails to compile, flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"foo": {
+											Type: "string",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"foo": {
+											Type:         "string",
+											XValidations: apiextensionsv1.ValidationRules{{Rule: "self >="}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &CELCost{},
+		},
+	}
+
+	internaltesting.RunTestcases(t, testcases...)
+}
+
+func TestCELCostReportsExpressionCost(t *testing.T) {
+	old := &apiextensionsv1.CustomResourceDefinition{
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{
+					Name: "v1alpha1",
+					Schema: &apiextensionsv1.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+							Type: "object",
+							Properties: map[string]apiextensionsv1.JSONSchemaProps{
+								"foo": {
+									Type:      "string",
+									MaxLength: ptr.To[int64](10),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	new := &apiextensionsv1.CustomResourceDefinition{
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{
+					Name: "v1alpha1",
+					Schema: &apiextensionsv1.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+							Type: "object",
+							Properties: map[string]apiextensionsv1.JSONSchemaProps{
+								"foo": {
+									Type:         "string",
+									MaxLength:    ptr.To[int64](10),
+									XValidations: apiextensionsv1.ValidationRules{{Rule: "self.matches('^a+$')"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	val := &CELCost{}
+	val.SetEnforcement(config.EnforcementPolicyError)
+
+	result := val.Compare(old, new)
+
+	if len(result.Errors) > 0 {
+		t.Fatalf("expected no errors, got %v", result.Errors)
+	}
+
+	expected := []string{
+		`CEL expression estimated cost : v1alpha1 ^.foo rule "self.matches('^a+$')" : estimated cost 6 (budget 10000000)`,
+		`CEL total estimated cost : v1alpha1 : estimated cost 6 (budget 100000000)`,
+	}
+
+	if !slices.Equal(result.Warnings, expected) {
+		t.Fatalf("expected warnings %q, got %q", expected, result.Warnings)
+	}
+}
+
+func TestCELCostIncrease(t *testing.T) {
+	testcases := []struct {
+		name         string
+		old          *apiextensionsv1.CustomResourceDefinition
+		new          *apiextensionsv1.CustomResourceDefinition
+		threshold    *int
+		wantWarnings []error
+	}{
+		{
+			name: "unchanged cost, only the total cost reported",
+			old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"foo": {
+											Type:         "string",
+											MaxLength:    ptr.To[int64](10),
+											XValidations: apiextensionsv1.ValidationRules{{Rule: "self.matches('^a+$')"}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			new: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"foo": {
+											Type:         "string",
+											MaxLength:    ptr.To[int64](10),
+											XValidations: apiextensionsv1.ValidationRules{{Rule: "self.matches('^a+$')"}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			threshold:    ptr.To(10),
+			wantWarnings: []error{ErrTotalCost},
+		},
+		{
+			name: "maxLength removed, rule and total cost increased",
+			old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"foo": {
+											Type:         "string",
+											MaxLength:    ptr.To[int64](10),
+											XValidations: apiextensionsv1.ValidationRules{{Rule: "self.matches('^a+$')"}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			new: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"foo": {
+											Type:         "string",
+											XValidations: apiextensionsv1.ValidationRules{{Rule: "self.matches('^a+$')"}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			threshold:    ptr.To(10),
+			wantWarnings: []error{ErrExpressionCostIncreased, ErrTotalCostIncreased},
+		},
+		{
+			name: "maxLength increased within threshold, the changed and total cost reported",
+			old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"foo": {
+											Type:         "string",
+											MaxLength:    ptr.To[int64](10),
+											XValidations: apiextensionsv1.ValidationRules{{Rule: "self.matches('^a+$')"}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			new: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"foo": {
+											Type:         "string",
+											MaxLength:    ptr.To[int64](50),
+											XValidations: apiextensionsv1.ValidationRules{{Rule: "self.matches('^a+$')"}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			threshold:    ptr.To(1000),
+			wantWarnings: []error{ErrExpressionCost, ErrTotalCost},
+		},
+		{
+			name: "maxLength increased with a zero threshold, rule and total cost increased",
+			old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"foo": {
+											Type:         "string",
+											MaxLength:    ptr.To[int64](10),
+											XValidations: apiextensionsv1.ValidationRules{{Rule: "self.matches('^a+$')"}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			new: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"foo": {
+											Type:         "string",
+											MaxLength:    ptr.To[int64](50),
+											XValidations: apiextensionsv1.ValidationRules{{Rule: "self.matches('^a+$')"}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			threshold:    ptr.To(0),
+			wantWarnings: []error{ErrExpressionCostIncreased, ErrTotalCostIncreased},
+		},
+		{
+			name: "rule changed, total cost increased",
+			old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"foo": {
+											Type:         "string",
+											MaxLength:    ptr.To[int64](10),
+											XValidations: apiextensionsv1.ValidationRules{{Rule: "self.matches('^a+$')"}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			new: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"foo": {
+											Type:         "string",
+											XValidations: apiextensionsv1.ValidationRules{{Rule: "self.matches('^b+$')"}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			threshold:    ptr.To(10),
+			wantWarnings: []error{ErrExpressionCost, ErrTotalCostIncreased},
+		},
+		{
+			name: "rules added to version without rules, the added and total cost reported",
+			old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"foo": {
+											Type: "string",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			new: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"foo": {
+											Type:         "string",
+											XValidations: apiextensionsv1.ValidationRules{{Rule: "self.matches('^a+$')"}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			threshold:    ptr.To(10),
+			wantWarnings: []error{ErrExpressionCost, ErrTotalCost},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			val := &CELCost{CELCostConfig: CELCostConfig{IncreaseThresholdPercent: tc.threshold}}
+			val.SetEnforcement(config.EnforcementPolicyError)
+
+			result := val.Compare(tc.old, tc.new)
+
+			if len(result.Errors) > 0 {
+				t.Fatalf("expected no errors, got %v", result.Errors)
+			}
+
+			if len(result.Warnings) != len(tc.wantWarnings) {
+				t.Fatalf("expected %d warnings, got %v", len(tc.wantWarnings), result.Warnings)
+			}
+
+			for i, want := range tc.wantWarnings {
+				if !strings.HasPrefix(result.Warnings[i], want.Error()+" : ") {
+					t.Fatalf("expected warning %q to be a %q warning", result.Warnings[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestEstimateVersionCost(t *testing.T) {
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{
+					Name: "v1alpha1",
+					Schema: &apiextensionsv1.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+							Type: "object",
+							Properties: map[string]apiextensionsv1.JSONSchemaProps{
+								"foo": {
+									Type: "array",
+									Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+										Schema: &apiextensionsv1.JSONSchemaProps{
+											Type: "string",
+											XValidations: apiextensionsv1.ValidationRules{
+												{Rule: "self.matches('^a+$')", MessageExpression: "'invalid value ' + self"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	vc := estimateVersionCost(crd.Spec.Versions[0])
+
+	if len(vc.errs) > 0 {
+		t.Fatalf("unexpected errors: %v", vc.errs)
+	}
+
+	if len(vc.expressions) != 2 {
+		t.Fatalf("expected a cost for both the rule and the messageExpression, got %v", vc.expressions)
+	}
+
+	var sum uint64
+	for _, expr := range vc.expressions {
+		if expr.path != "^.foo.items" {
+			t.Fatalf("expected expression at path %q, got %q", "^.foo.items", expr.path)
+		}
+
+		sum += expr.cost
+	}
+
+	if vc.total != sum {
+		t.Fatalf("expected total %d to equal the sum of expression costs %d", vc.total, sum)
+	}
+
+	// the rule is evaluated for every item in an unbounded list
+	// so it is more expensive than the messageExpression
+	if vc.expressions[0].cost <= vc.expressions[1].cost {
+		t.Fatalf("expected rule cost to account for the cardinality of the list, got %v", vc.expressions)
+	}
+}
+
+func TestValidateCELCostConfig(t *testing.T) {
+	testcases := []struct {
+		name    string
+		cfg     *CELCostConfig
+		wantErr error
+	}{
+		{
+			name: "nil config",
+			cfg:  nil,
+		},
+		{
+			name: "empty config",
+			cfg:  &CELCostConfig{},
+		},
+		{
+			name: "threshold set",
+			cfg:  &CELCostConfig{IncreaseThresholdPercent: ptr.To(50)},
+		},
+		{
+			name: "zero threshold",
+			cfg:  &CELCostConfig{IncreaseThresholdPercent: ptr.To(0)},
+		},
+		{
+			name:    "negative threshold",
+			cfg:     &CELCostConfig{IncreaseThresholdPercent: ptr.To(-1)},
+			wantErr: errInvalidIncreaseThresholdPercent,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateCELCostConfig(tc.cfg)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
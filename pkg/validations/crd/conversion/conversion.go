@@ -0,0 +1,229 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversion
+
+import (
+	"errors"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+)
+
+var (
+	_ validations.Validation                                           = (*Conversion)(nil)
+	_ validations.Comparator[apiextensionsv1.CustomResourceDefinition] = (*Conversion)(nil)
+)
+
+const (
+	name = "conversion"
+
+	// defaultServicePort is the port the API server uses to
+	// call a conversion webhook service when none is set.
+	defaultServicePort = 443
+)
+
+// Register registers the Conversion validation
+// with the provided validation registry.
+func Register(registry validations.Registry) {
+	registry.Register(name, factory)
+}
+
+// factory is a function used to initialize a Conversion validation
+// implementation based on the provided configuration.
+func factory(_ map[string]interface{}) (validations.Validation, error) {
+	return &Conversion{}, nil
+}
+
+// Conversion is a validations.Validation implementation
+// used to check if the conversion strategy or conversion webhook
+// configuration has changed from one CRD instance to another.
+type Conversion struct {
+	// enforcement is the EnforcementPolicy that this validation
+	// should use when performing its validation logic
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the Conversion validation.
+func (c *Conversion) Name() string {
+	return name
+}
+
+// SetEnforcement sets the EnforcementPolicy for the Conversion validation.
+func (c *Conversion) SetEnforcement(enforcement config.EnforcementPolicy) {
+	c.enforcement = enforcement
+}
+
+// Compare compares an old and a new CustomResourceDefinition, checking for the conversion strategy changing from
+// Webhook to None while served versions of the new CustomResourceDefinition have different schemas, for
+// ConversionReview versions that are no longer accepted by the conversion webhook and for changes to the
+// service or URL the conversion webhook is called through.
+func (c *Conversion) Compare(a, b *apiextensionsv1.CustomResourceDefinition) validations.ComparisonResult {
+	errs := []error{}
+
+	oldStrategy, newStrategy := strategyOf(a), strategyOf(b)
+
+	switch {
+	case oldStrategy == apiextensionsv1.WebhookConverter && newStrategy == apiextensionsv1.NoneConverter:
+		if versions := servedVersionsWithDifferentSchemas(b); len(versions) > 0 {
+			errs = append(errs, fmt.Errorf("%w : %q -> %q : served versions %v have different schemas but objects are no longer converted between them",
+				ErrConversionWebhookRemoved, oldStrategy, newStrategy, versions))
+		}
+	case oldStrategy == apiextensionsv1.WebhookConverter && newStrategy == apiextensionsv1.WebhookConverter:
+		errs = append(errs, compareWebhooks(a.Spec.Conversion.Webhook, b.Spec.Conversion.Webhook)...)
+	}
+
+	return validations.HandleErrors(c.Name(), c.enforcement, errs...)
+}
+
+// strategyOf returns the conversion strategy of the provided CustomResourceDefinition,
+// which the API server defaults to None.
+func strategyOf(crd *apiextensionsv1.CustomResourceDefinition) apiextensionsv1.ConversionStrategyType {
+	if crd.Spec.Conversion == nil || crd.Spec.Conversion.Strategy == "" {
+		return apiextensionsv1.NoneConverter
+	}
+
+	return crd.Spec.Conversion.Strategy
+}
+
+// servedVersionsWithDifferentSchemas returns the names of the served versions of the provided
+// CustomResourceDefinition when not all of them have the same schema.
+func servedVersionsWithDifferentSchemas(crd *apiextensionsv1.CustomResourceDefinition) []string {
+	served := []apiextensionsv1.CustomResourceDefinitionVersion{}
+
+	for _, version := range crd.Spec.Versions {
+		if version.Served {
+			served = append(served, version)
+		}
+	}
+
+	for _, version := range served {
+		if !equality.Semantic.DeepEqual(version.Schema, served[0].Schema) {
+			names := []string{}
+			for _, version := range served {
+				names = append(names, version.Name)
+			}
+
+			return names
+		}
+	}
+
+	return nil
+}
+
+// compareWebhooks compares the old and new conversion webhook configuration.
+func compareWebhooks(oldWebhook, newWebhook *apiextensionsv1.WebhookConversion) []error {
+	if oldWebhook == nil {
+		oldWebhook = &apiextensionsv1.WebhookConversion{}
+	}
+
+	if newWebhook == nil {
+		newWebhook = &apiextensionsv1.WebhookConversion{}
+	}
+
+	errs := []error{}
+
+	removed := sets.New(oldWebhook.ConversionReviewVersions...).Difference(sets.New(newWebhook.ConversionReviewVersions...))
+	if removed.Len() > 0 {
+		errs = append(errs, fmt.Errorf("%w : %v : API servers that only send these ConversionReview versions can no longer call the conversion webhook",
+			ErrConversionReviewVersionsRemoved, sets.List(removed)))
+	}
+
+	oldClientConfig, newClientConfig := clientConfigOf(oldWebhook), clientConfigOf(newWebhook)
+
+	switch {
+	case oldClientConfig.Service != nil && newClientConfig.Service != nil:
+		errs = append(errs, compareServices(*oldClientConfig.Service, *newClientConfig.Service)...)
+	case oldClientConfig.Service == nil && newClientConfig.Service == nil:
+		oldURL, newURL := ptr.Deref(oldClientConfig.URL, ""), ptr.Deref(newClientConfig.URL, "")
+		if oldURL != newURL {
+			errs = append(errs, fmt.Errorf("%w : %q -> %q : the conversion webhook must be reachable at the new URL before the CRD is updated",
+				ErrConversionWebhookURLChanged, oldURL, newURL))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("%w : %s -> %s : the conversion webhook must be reachable at the new location before the CRD is updated",
+			ErrConversionWebhookClientConfigChanged, describeClientConfig(oldClientConfig), describeClientConfig(newClientConfig)))
+	}
+
+	return errs
+}
+
+// compareServices compares the old and new service a conversion webhook is called through.
+func compareServices(oldService, newService apiextensionsv1.ServiceReference) []error {
+	errs := []error{}
+
+	fields := []struct {
+		name     string
+		old, new string
+	}{
+		{name: "name", old: oldService.Name, new: newService.Name},
+		{name: "namespace", old: oldService.Namespace, new: newService.Namespace},
+		{name: "path", old: ptr.Deref(oldService.Path, ""), new: ptr.Deref(newService.Path, "")},
+		{name: "port", old: fmt.Sprint(ptr.Deref(oldService.Port, defaultServicePort)), new: fmt.Sprint(ptr.Deref(newService.Port, defaultServicePort))},
+	}
+
+	for _, field := range fields {
+		if field.old != field.new {
+			errs = append(errs, fmt.Errorf("%w : %s %q -> %q : the conversion webhook must be reachable at the new location before the CRD is updated",
+				ErrConversionWebhookServiceChanged, field.name, field.old, field.new))
+		}
+	}
+
+	return errs
+}
+
+// clientConfigOf returns the client configuration used to call a conversion webhook,
+// or an empty client configuration when none is set.
+func clientConfigOf(webhook *apiextensionsv1.WebhookConversion) apiextensionsv1.WebhookClientConfig {
+	if webhook.ClientConfig == nil {
+		return apiextensionsv1.WebhookClientConfig{}
+	}
+
+	return *webhook.ClientConfig
+}
+
+// describeClientConfig returns a description of how a conversion webhook
+// is called using the provided client configuration.
+func describeClientConfig(clientConfig apiextensionsv1.WebhookClientConfig) string {
+	if clientConfig.Service != nil {
+		return fmt.Sprintf("service %q", clientConfig.Service.Namespace+"/"+clientConfig.Service.Name)
+	}
+
+	return fmt.Sprintf("URL %q", ptr.Deref(clientConfig.URL, ""))
+}
+
+// ErrConversionWebhookRemoved represents an error state where the conversion strategy changed
+// from Webhook to None while served versions have different schemas.
+var ErrConversionWebhookRemoved = errors.New("conversion webhook removed")
+
+// ErrConversionReviewVersionsRemoved represents an error state where ConversionReview versions
+// are no longer accepted by the conversion webhook.
+var ErrConversionReviewVersionsRemoved = errors.New("conversion review versions removed")
+
+// ErrConversionWebhookServiceChanged represents an error state where the service
+// the conversion webhook is called through changed.
+var ErrConversionWebhookServiceChanged = errors.New("conversion webhook service changed")
+
+// ErrConversionWebhookURLChanged represents an error state where the URL
+// the conversion webhook is called through changed.
+var ErrConversionWebhookURLChanged = errors.New("conversion webhook URL changed")
+
+// ErrConversionWebhookClientConfigChanged represents an error state where the conversion webhook
+// switched between being called through a service and being called through a URL.
+var ErrConversionWebhookClientConfigChanged = errors.New("conversion webhook client config changed")
@@ -0,0 +1,1079 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversion
+
+import (
+	"slices"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/crdify/pkg/config"
+	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
+)
+
+func TestConversion(t *testing.T) {
+	testcases := []internaltesting.Testcase[apiextensionsv1.CustomResourceDefinition]{
+		{
+			Name: "no conversion changed, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "string"},
+									},
+								},
+							},
+						},
+						{
+							Name:   "v1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+					Conversion: &apiextensionsv1.CustomResourceConversion{
+						Strategy: apiextensionsv1.WebhookConverter,
+						Webhook: &apiextensionsv1.WebhookConversion{
+							ClientConfig: &apiextensionsv1.WebhookClientConfig{
+								Service: &apiextensionsv1.ServiceReference{
+									Name:      "webhook",
+									Namespace: "system",
+									Path:      ptr.To("/convert"),
+								},
+							},
+							ConversionReviewVersions: []string{"v1"},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "string"},
+									},
+								},
+							},
+						},
+						{
+							Name:   "v1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+					Conversion: &apiextensionsv1.CustomResourceConversion{
+						Strategy: apiextensionsv1.WebhookConverter,
+						Webhook: &apiextensionsv1.WebhookConversion{
+							ClientConfig: &apiextensionsv1.WebhookClientConfig{
+								Service: &apiextensionsv1.ServiceReference{
+									Name:      "webhook",
+									Namespace: "system",
+									Path:      ptr.To("/convert"),
+								},
+							},
+							ConversionReviewVersions: []string{"v1"},
+						},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &Conversion{},
+		},
+		{
+			Name: "webhook to none with different served schemas, flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "string"},
+									},
+								},
+							},
+						},
+						{
+							Name:   "v1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+					Conversion: &apiextensionsv1.CustomResourceConversion{
+						Strategy: apiextensionsv1.WebhookConverter,
+						Webhook: &apiextensionsv1.WebhookConversion{
+							ClientConfig: &apiextensionsv1.WebhookClientConfig{
+								Service: &apiextensionsv1.ServiceReference{
+									Name:      "webhook",
+									Namespace: "system",
+									Path:      ptr.To("/convert"),
+								},
+							},
+							ConversionReviewVersions: []string{"v1"},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "string"},
+									},
+								},
+							},
+						},
+						{
+							Name:   "v1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+					Conversion: &apiextensionsv1.CustomResourceConversion{Strategy: apiextensionsv1.NoneConverter},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &Conversion{},
+		},
+		{
+			Name: "webhook to unset conversion with different served schemas, flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "string"},
+									},
+								},
+							},
+						},
+						{
+							Name:   "v1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+					Conversion: &apiextensionsv1.CustomResourceConversion{
+						Strategy: apiextensionsv1.WebhookConverter,
+						Webhook: &apiextensionsv1.WebhookConversion{
+							ClientConfig: &apiextensionsv1.WebhookClientConfig{
+								Service: &apiextensionsv1.ServiceReference{
+									Name:      "webhook",
+									Namespace: "system",
+									Path:      ptr.To("/convert"),
+								},
+							},
+							ConversionReviewVersions: []string{"v1"},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "string"},
+									},
+								},
+							},
+						},
+						{
+							Name:   "v1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &Conversion{},
+		},
+		{
+			Name: "webhook to none with identical served schemas, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+						{
+							Name:   "v1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+					Conversion: &apiextensionsv1.CustomResourceConversion{
+						Strategy: apiextensionsv1.WebhookConverter,
+						Webhook: &apiextensionsv1.WebhookConversion{
+							ClientConfig: &apiextensionsv1.WebhookClientConfig{
+								Service: &apiextensionsv1.ServiceReference{
+									Name:      "webhook",
+									Namespace: "system",
+									Path:      ptr.To("/convert"),
+								},
+							},
+							ConversionReviewVersions: []string{"v1"},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+						{
+							Name:   "v1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+					Conversion: &apiextensionsv1.CustomResourceConversion{Strategy: apiextensionsv1.NoneConverter},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &Conversion{},
+		},
+		{
+			Name: "none to webhook, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "string"},
+									},
+								},
+							},
+						},
+						{
+							Name:   "v1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+					Conversion: &apiextensionsv1.CustomResourceConversion{Strategy: apiextensionsv1.NoneConverter},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "string"},
+									},
+								},
+							},
+						},
+						{
+							Name:   "v1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+					Conversion: &apiextensionsv1.CustomResourceConversion{
+						Strategy: apiextensionsv1.WebhookConverter,
+						Webhook: &apiextensionsv1.WebhookConversion{
+							ClientConfig: &apiextensionsv1.WebhookClientConfig{
+								Service: &apiextensionsv1.ServiceReference{
+									Name:      "webhook",
+									Namespace: "system",
+									Path:      ptr.To("/convert"),
+								},
+							},
+							ConversionReviewVersions: []string{"v1"},
+						},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &Conversion{},
+		},
+		{
+			Name: "conversion review version added, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "string"},
+									},
+								},
+							},
+						},
+						{
+							Name:   "v1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+					Conversion: &apiextensionsv1.CustomResourceConversion{
+						Strategy: apiextensionsv1.WebhookConverter,
+						Webhook: &apiextensionsv1.WebhookConversion{
+							ClientConfig: &apiextensionsv1.WebhookClientConfig{
+								Service: &apiextensionsv1.ServiceReference{
+									Name:      "webhook",
+									Namespace: "system",
+									Path:      ptr.To("/convert"),
+								},
+							},
+							ConversionReviewVersions: []string{"v1"},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "string"},
+									},
+								},
+							},
+						},
+						{
+							Name:   "v1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+					Conversion: &apiextensionsv1.CustomResourceConversion{
+						Strategy: apiextensionsv1.WebhookConverter,
+						Webhook: &apiextensionsv1.WebhookConversion{
+							ClientConfig: &apiextensionsv1.WebhookClientConfig{
+								Service: &apiextensionsv1.ServiceReference{
+									Name:      "webhook",
+									Namespace: "system",
+									Path:      ptr.To("/convert"),
+								},
+							},
+							ConversionReviewVersions: []string{"v1", "v1beta1"},
+						},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &Conversion{},
+		},
+		{
+			Name: "conversion review version removed, flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "string"},
+									},
+								},
+							},
+						},
+						{
+							Name:   "v1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+					Conversion: &apiextensionsv1.CustomResourceConversion{
+						Strategy: apiextensionsv1.WebhookConverter,
+						Webhook: &apiextensionsv1.WebhookConversion{
+							ClientConfig: &apiextensionsv1.WebhookClientConfig{
+								Service: &apiextensionsv1.ServiceReference{
+									Name:      "webhook",
+									Namespace: "system",
+									Path:      ptr.To("/convert"),
+								},
+							},
+							ConversionReviewVersions: []string{"v1", "v1beta1"},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "string"},
+									},
+								},
+							},
+						},
+						{
+							Name:   "v1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+					Conversion: &apiextensionsv1.CustomResourceConversion{
+						Strategy: apiextensionsv1.WebhookConverter,
+						Webhook: &apiextensionsv1.WebhookConversion{
+							ClientConfig: &apiextensionsv1.WebhookClientConfig{
+								Service: &apiextensionsv1.ServiceReference{
+									Name:      "webhook",
+									Namespace: "system",
+									Path:      ptr.To("/convert"),
+								},
+							},
+							ConversionReviewVersions: []string{"v1"},
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &Conversion{},
+		},
+		{
+			Name: "service namespace changed, flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "string"},
+									},
+								},
+							},
+						},
+						{
+							Name:   "v1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+					Conversion: &apiextensionsv1.CustomResourceConversion{
+						Strategy: apiextensionsv1.WebhookConverter,
+						Webhook: &apiextensionsv1.WebhookConversion{
+							ClientConfig: &apiextensionsv1.WebhookClientConfig{
+								Service: &apiextensionsv1.ServiceReference{
+									Name:      "webhook",
+									Namespace: "system",
+									Path:      ptr.To("/convert"),
+								},
+							},
+							ConversionReviewVersions: []string{"v1"},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "string"},
+									},
+								},
+							},
+						},
+						{
+							Name:   "v1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+					Conversion: &apiextensionsv1.CustomResourceConversion{
+						Strategy: apiextensionsv1.WebhookConverter,
+						Webhook: &apiextensionsv1.WebhookConversion{
+							ClientConfig: &apiextensionsv1.WebhookClientConfig{
+								Service: &apiextensionsv1.ServiceReference{
+									Name:      "webhook",
+									Namespace: "operators",
+									Path:      ptr.To("/convert"),
+								},
+							},
+							ConversionReviewVersions: []string{"v1"},
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &Conversion{},
+		},
+		{
+			Name: "service port set to the default, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "string"},
+									},
+								},
+							},
+						},
+						{
+							Name:   "v1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+					Conversion: &apiextensionsv1.CustomResourceConversion{
+						Strategy: apiextensionsv1.WebhookConverter,
+						Webhook: &apiextensionsv1.WebhookConversion{
+							ClientConfig: &apiextensionsv1.WebhookClientConfig{
+								Service: &apiextensionsv1.ServiceReference{
+									Name:      "webhook",
+									Namespace: "system",
+									Path:      ptr.To("/convert"),
+								},
+							},
+							ConversionReviewVersions: []string{"v1"},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "string"},
+									},
+								},
+							},
+						},
+						{
+							Name:   "v1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+					Conversion: &apiextensionsv1.CustomResourceConversion{
+						Strategy: apiextensionsv1.WebhookConverter,
+						Webhook: &apiextensionsv1.WebhookConversion{
+							ClientConfig: &apiextensionsv1.WebhookClientConfig{
+								Service: &apiextensionsv1.ServiceReference{
+									Name:      "webhook",
+									Namespace: "system",
+									Path:      ptr.To("/convert"),
+									Port:      ptr.To[int32](443),
+								},
+							},
+							ConversionReviewVersions: []string{"v1"},
+						},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &Conversion{},
+		},
+		{
+			Name: "service port changed, flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "string"},
+									},
+								},
+							},
+						},
+						{
+							Name:   "v1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+					Conversion: &apiextensionsv1.CustomResourceConversion{
+						Strategy: apiextensionsv1.WebhookConverter,
+						Webhook: &apiextensionsv1.WebhookConversion{
+							ClientConfig: &apiextensionsv1.WebhookClientConfig{
+								Service: &apiextensionsv1.ServiceReference{
+									Name:      "webhook",
+									Namespace: "system",
+									Path:      ptr.To("/convert"),
+								},
+							},
+							ConversionReviewVersions: []string{"v1"},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "string"},
+									},
+								},
+							},
+						},
+						{
+							Name:   "v1",
+							Served: true,
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+					Conversion: &apiextensionsv1.CustomResourceConversion{
+						Strategy: apiextensionsv1.WebhookConverter,
+						Webhook: &apiextensionsv1.WebhookConversion{
+							ClientConfig: &apiextensionsv1.WebhookClientConfig{
+								Service: &apiextensionsv1.ServiceReference{
+									Name:      "webhook",
+									Namespace: "system",
+									Path:      ptr.To("/convert"),
+									Port:      ptr.To[int32](9443),
+								},
+							},
+							ConversionReviewVersions: []string{"v1"},
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &Conversion{},
+		},
+	}
+
+	internaltesting.RunTestcases(t, testcases...)
+}
+
+func TestConversionWebhookChanges(t *testing.T) {
+	val := &Conversion{}
+	val.SetEnforcement(config.EnforcementPolicyError)
+
+	result := val.Compare(
+		&apiextensionsv1.CustomResourceDefinition{
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{
+						Name:   "v1alpha1",
+						Served: true,
+						Schema: &apiextensionsv1.CustomResourceValidation{
+							OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+								Type: "object",
+								Properties: map[string]apiextensionsv1.JSONSchemaProps{
+									"spec": {Type: "string"},
+								},
+							},
+						},
+					},
+					{
+						Name:   "v1",
+						Served: true,
+						Schema: &apiextensionsv1.CustomResourceValidation{
+							OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+								Type: "object",
+								Properties: map[string]apiextensionsv1.JSONSchemaProps{
+									"spec": {Type: "object"},
+								},
+							},
+						},
+					},
+				},
+				Conversion: &apiextensionsv1.CustomResourceConversion{
+					Strategy: apiextensionsv1.WebhookConverter,
+					Webhook: &apiextensionsv1.WebhookConversion{
+						ClientConfig: &apiextensionsv1.WebhookClientConfig{
+							Service: &apiextensionsv1.ServiceReference{
+								Name:      "webhook",
+								Namespace: "system",
+								Path:      ptr.To("/convert"),
+							},
+						},
+						ConversionReviewVersions: []string{"v1", "v1beta1"},
+					},
+				},
+			},
+		},
+		&apiextensionsv1.CustomResourceDefinition{
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{
+						Name:   "v1alpha1",
+						Served: true,
+						Schema: &apiextensionsv1.CustomResourceValidation{
+							OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+								Type: "object",
+								Properties: map[string]apiextensionsv1.JSONSchemaProps{
+									"spec": {Type: "string"},
+								},
+							},
+						},
+					},
+					{
+						Name:   "v1",
+						Served: true,
+						Schema: &apiextensionsv1.CustomResourceValidation{
+							OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+								Type: "object",
+								Properties: map[string]apiextensionsv1.JSONSchemaProps{
+									"spec": {Type: "object"},
+								},
+							},
+						},
+					},
+				},
+				Conversion: &apiextensionsv1.CustomResourceConversion{
+					Strategy: apiextensionsv1.WebhookConverter,
+					Webhook: &apiextensionsv1.WebhookConversion{
+						ClientConfig: &apiextensionsv1.WebhookClientConfig{
+							Service: &apiextensionsv1.ServiceReference{
+								Name:      "converter",
+								Namespace: "system",
+								Path:      ptr.To("/convert"),
+								Port:      ptr.To[int32](9443),
+							},
+						},
+						ConversionReviewVersions: []string{"v1"},
+					},
+				},
+			},
+		},
+	)
+
+	expected := []string{
+		`conversion review versions removed : [v1beta1] : API servers that only send these ConversionReview versions can no longer call the conversion webhook`,
+		`conversion webhook service changed : name "webhook" -> "converter" : the conversion webhook must be reachable at the new location before the CRD is updated`,
+		`conversion webhook service changed : port "443" -> "9443" : the conversion webhook must be reachable at the new location before the CRD is updated`,
+	}
+
+	if !slices.Equal(result.Errors, expected) {
+		t.Fatalf("expected errors %q, got %q", expected, result.Errors)
+	}
+}
+
+func TestConversionWebhookClientConfigChanges(t *testing.T) {
+	testcases := []struct {
+		name     string
+		old, new *apiextensionsv1.WebhookClientConfig
+		expected []string
+	}{
+		{
+			name:     "URL unchanged",
+			old:      &apiextensionsv1.WebhookClientConfig{URL: ptr.To("https://webhook.example.com/convert")},
+			new:      &apiextensionsv1.WebhookClientConfig{URL: ptr.To("https://webhook.example.com/convert")},
+			expected: []string{},
+		},
+		{
+			name: "URL changed",
+			old:  &apiextensionsv1.WebhookClientConfig{URL: ptr.To("https://webhook.example.com/convert")},
+			new:  &apiextensionsv1.WebhookClientConfig{URL: ptr.To("https://converter.example.com/convert")},
+			expected: []string{
+				`conversion webhook URL changed : "https://webhook.example.com/convert" -> "https://converter.example.com/convert" : the conversion webhook must be reachable at the new URL before the CRD is updated`,
+			},
+		},
+		{
+			name: "service to URL",
+			old: &apiextensionsv1.WebhookClientConfig{
+				Service: &apiextensionsv1.ServiceReference{Name: "webhook", Namespace: "system", Path: ptr.To("/convert")},
+			},
+			new: &apiextensionsv1.WebhookClientConfig{URL: ptr.To("https://webhook.example.com/convert")},
+			expected: []string{
+				`conversion webhook client config changed : service "system/webhook" -> URL "https://webhook.example.com/convert" : the conversion webhook must be reachable at the new location before the CRD is updated`,
+			},
+		},
+		{
+			name: "URL to service",
+			old:  &apiextensionsv1.WebhookClientConfig{URL: ptr.To("https://webhook.example.com/convert")},
+			new: &apiextensionsv1.WebhookClientConfig{
+				Service: &apiextensionsv1.ServiceReference{Name: "webhook", Namespace: "system", Path: ptr.To("/convert")},
+			},
+			expected: []string{
+				`conversion webhook client config changed : URL "https://webhook.example.com/convert" -> service "system/webhook" : the conversion webhook must be reachable at the new location before the CRD is updated`,
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			val := &Conversion{}
+			val.SetEnforcement(config.EnforcementPolicyError)
+
+			result := val.Compare(
+				&apiextensionsv1.CustomResourceDefinition{
+					Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+						Conversion: &apiextensionsv1.CustomResourceConversion{
+							Strategy: apiextensionsv1.WebhookConverter,
+							Webhook: &apiextensionsv1.WebhookConversion{
+								ClientConfig:             tc.old,
+								ConversionReviewVersions: []string{"v1"},
+							},
+						},
+					},
+				},
+				&apiextensionsv1.CustomResourceDefinition{
+					Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+						Conversion: &apiextensionsv1.CustomResourceConversion{
+							Strategy: apiextensionsv1.WebhookConverter,
+							Webhook: &apiextensionsv1.WebhookConversion{
+								ClientConfig:             tc.new,
+								ConversionReviewVersions: []string{"v1"},
+							},
+						},
+					},
+				},
+			)
+
+			if !slices.Equal(result.Errors, tc.expected) {
+				t.Fatalf("expected errors %q, got %q", tc.expected, result.Errors)
+			}
+		})
+	}
+}
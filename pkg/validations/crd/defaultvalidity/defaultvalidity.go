@@ -0,0 +1,151 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaultvalidity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+)
+
+var (
+	_ validations.Validation                                           = (*DefaultValidity)(nil)
+	_ validations.Comparator[apiextensionsv1.CustomResourceDefinition] = (*DefaultValidity)(nil)
+)
+
+const name = "defaultValidity"
+
+// Register registers the DefaultValidity validation
+// with the provided validation registry.
+func Register(registry validations.Registry) {
+	registry.Register(name, factory)
+}
+
+// factory is a function used to initialize a DefaultValidity validation
+// implementation based on the provided configuration.
+func factory(_ map[string]interface{}) (validations.Validation, error) {
+	return &DefaultValidity{}, nil
+}
+
+// DefaultValidity is a validations.Validation implementation
+// used to check if the default values of the properties of a
+// CRD instance are valid according to the schema of the
+// property they belong to.
+type DefaultValidity struct {
+	// enforcement is the EnforcementPolicy that this validation
+	// should use when performing its validation logic
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the DefaultValidity validation.
+func (dv *DefaultValidity) Name() string {
+	return name
+}
+
+// SetEnforcement sets the EnforcementPolicy for the DefaultValidity validation.
+func (dv *DefaultValidity) SetEnforcement(policy config.EnforcementPolicy) {
+	dv.enforcement = policy
+}
+
+// Compare validates the default value of every property of every version of the new CustomResourceDefinition
+// against the schema of the property, including its children schemas, the same way the API server validates objects.
+// This catches changed and net-new defaults that violate the constraints of their property as well as changed
+// constraints that an existing default violates. The old CustomResourceDefinition is not evaluated, changes to the
+// default values themselves are evaluated by the default validation.
+func (dv *DefaultValidity) Compare(_, b *apiextensionsv1.CustomResourceDefinition) validations.ComparisonResult {
+	errs := []error{}
+
+	for _, version := range b.Spec.Versions {
+		if version.Schema == nil {
+			continue
+		}
+
+		properties := validations.FlattenCRDVersion(version)
+
+		for _, property := range slices.Sorted(maps.Keys(properties)) {
+			if properties[property].Default == nil {
+				continue
+			}
+
+			errs = append(errs, validateDefault(version.Name+"."+property, properties[property])...)
+		}
+	}
+
+	return validations.HandleErrors(dv.Name(), dv.enforcement, errs...)
+}
+
+// validateDefault validates the default value of s against s, returning an
+// error for each constraint of s that the default value violates.
+// property is the path of s that is included in the returned errors.
+func validateDefault(property string, s *apiextensionsv1.JSONSchemaProps) []error {
+	var value interface{}
+
+	err := json.Unmarshal(s.Default.Raw, &value)
+	if err != nil {
+		return []error{fmt.Errorf("%w : %s : %w", ErrDefaultValidation, property, err)}
+	}
+
+	validator, err := schemaValidator(s)
+	if err != nil {
+		return []error{fmt.Errorf("%w : %s : %w", ErrDefaultValidation, property, err)}
+	}
+
+	errs := []error{}
+	for _, fieldErr := range apiservervalidation.ValidateCustomResource(field.NewPath("default"), value, validator) {
+		errs = append(errs, fmt.Errorf("%w : %s : %s", ErrInvalidDefault, property, fieldErr.Error()))
+	}
+
+	return errs
+}
+
+// schemaValidator returns the validator the API server uses to validate
+// values against s. Only the constraints of s are used, defaults are ignored.
+func schemaValidator(s *apiextensionsv1.JSONSchemaProps) (apiservervalidation.SchemaCreateValidator, error) {
+	internalSchema := &apiextensions.JSONSchemaProps{}
+
+	err := apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(s, internalSchema, nil)
+	if err != nil {
+		return nil, fmt.Errorf("converting schema: %w", err)
+	}
+
+	structural, err := structuralschema.NewStructural(internalSchema)
+	if err != nil {
+		// schemas that are not structural are validated as they are
+		validator, _, err := apiservervalidation.NewSchemaValidator(internalSchema)
+		if err != nil {
+			return nil, fmt.Errorf("building schema validator: %w", err)
+		}
+
+		return validator, nil
+	}
+
+	return apiservervalidation.NewSchemaValidatorFromOpenAPI(structural.ToKubeOpenAPI()), nil
+}
+
+// ErrInvalidDefault represents an error state when a default value violates the constraints of its property.
+var ErrInvalidDefault = errors.New("invalid default")
+
+// ErrDefaultValidation represents an error state when a default value could not be validated.
+var ErrDefaultValidation = errors.New("unable to validate default")
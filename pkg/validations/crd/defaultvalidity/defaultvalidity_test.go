@@ -0,0 +1,220 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaultvalidity
+
+import (
+	"slices"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/crdify/pkg/config"
+	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
+)
+
+func TestDefaultValidity(t *testing.T) {
+	testcases := []internaltesting.Testcase[apiextensionsv1.CustomResourceDefinition]{
+		{
+			Name: "valid default, not flagged",
+			Old:  &apiextensionsv1.CustomResourceDefinition{},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"mode": {
+											Type:    "string",
+											Enum:    []apiextensionsv1.JSON{{Raw: []byte(`"a"`)}, {Raw: []byte(`"b"`)}},
+											Default: &apiextensionsv1.JSON{Raw: []byte(`"a"`)},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &DefaultValidity{},
+		},
+		{
+			Name: "default outside of the enum, flagged",
+			Old:  &apiextensionsv1.CustomResourceDefinition{},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"mode": {
+											Type:    "string",
+											Enum:    []apiextensionsv1.JSON{{Raw: []byte(`"a"`)}, {Raw: []byte(`"b"`)}},
+											Default: &apiextensionsv1.JSON{Raw: []byte(`"c"`)},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &DefaultValidity{},
+		},
+		{
+			Name: "default failing the pattern, flagged",
+			Old:  &apiextensionsv1.CustomResourceDefinition{},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"name": {
+											Type:    "string",
+											Pattern: "^[a-z]+$",
+											Default: &apiextensionsv1.JSON{Raw: []byte(`"Foo"`)},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &DefaultValidity{},
+		},
+		{
+			Name: "object default violating a child constraint, flagged",
+			Old:  &apiextensionsv1.CustomResourceDefinition{},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type: "object",
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"replicas": {Type: "integer", Maximum: ptr.To(3.0)},
+											},
+											Default: &apiextensionsv1.JSON{Raw: []byte(`{"replicas": 5}`)},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &DefaultValidity{},
+		},
+		{
+			Name: "version without a schema, not flagged",
+			Old:  &apiextensionsv1.CustomResourceDefinition{},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{Name: "v1"},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &DefaultValidity{},
+		},
+	}
+
+	internaltesting.RunTestcases(t, testcases...)
+}
+
+func TestDefaultValidityNetNewProperty(t *testing.T) {
+	val := &DefaultValidity{}
+	val.SetEnforcement(config.EnforcementPolicyError)
+
+	result := val.Compare(
+		&apiextensionsv1.CustomResourceDefinition{
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{
+						Name: "v1",
+						Schema: &apiextensionsv1.CustomResourceValidation{
+							OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+								Type: "object",
+								Properties: map[string]apiextensionsv1.JSONSchemaProps{
+									"spec": {
+										Type: "object",
+										Properties: map[string]apiextensionsv1.JSONSchemaProps{
+											"a": {Type: "integer"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		&apiextensionsv1.CustomResourceDefinition{
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{
+						Name: "v1",
+						Schema: &apiextensionsv1.CustomResourceValidation{
+							OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+								Type: "object",
+								Properties: map[string]apiextensionsv1.JSONSchemaProps{
+									"spec": {
+										Type: "object",
+										Properties: map[string]apiextensionsv1.JSONSchemaProps{
+											"a": {Type: "integer"},
+											"b": {
+												Type:    "integer",
+												Minimum: ptr.To(4.0),
+												Default: &apiextensionsv1.JSON{Raw: []byte(`2`)},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	)
+
+	expected := []string{
+		`invalid default : v1.^.spec.b : default: Invalid value: 2:  in body should be greater than or equal to 4`,
+	}
+
+	if !slices.Equal(result.Errors, expected) {
+		t.Fatalf("expected errors %q, got %q", expected, result.Errors)
+	}
+}
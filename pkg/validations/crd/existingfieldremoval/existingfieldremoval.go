@@ -74,10 +74,10 @@ func (efr *ExistingFieldRemoval) Compare(a, b *apiextensionsv1.CustomResourceDef
 			continue
 		}
 
-		existingFields := getFields(existingVersion)
-		newFields := getFields(&newVersion)
-
-		removedFields := existingFields.Difference(newFields)
+		removedFields := getRemovedFields(
+			validations.FlattenCRDVersion(*existingVersion),
+			validations.FlattenCRDVersion(newVersion),
+		)
 		for _, removedField := range removedFields.UnsortedList() {
 			errs = append(errs, fmt.Errorf("%w : %v.%v", ErrRemovedExistingField, newVersion.Name, removedField))
 		}
@@ -90,16 +90,18 @@ func (efr *ExistingFieldRemoval) Compare(a, b *apiextensionsv1.CustomResourceDef
 // from the CustomResourceDefinition.
 var ErrRemovedExistingField = errors.New("removed field")
 
-// getFields returns a set of all the fields for the provided CustomResourceDefinitionVersion.
-func getFields(v *apiextensionsv1.CustomResourceDefinitionVersion) sets.Set[string] {
-	fields := sets.New[string]()
-
-	validations.SchemaHas(v.Schema.OpenAPIV3Schema, field.NewPath("^"), field.NewPath("^"), nil,
-		func(s *apiextensionsv1.JSONSchemaProps, fldPath, simpleLocation *field.Path, _ []*apiextensionsv1.JSONSchemaProps) bool {
-			fields.Insert(simpleLocation.String())
-			return false
-		},
-	)
+// getRemovedFields returns the set of fields that exist in the provided old flattened schema but not in the new one.
+// The branches of allOf, anyOf, oneOf and not are only identified by their index, and pattern properties
+// by a pattern that may be rewritten, so they are not flattened as reordering branches or rewriting patterns
+// would otherwise be reported as removed fields. The fields of pattern properties that exist under the same
+// pattern in the old and new schema are compared instead.
+func getRemovedFields(existingFields, newFields map[string]*apiextensionsv1.JSONSchemaProps) sets.Set[string] {
+	removedFields := sets.KeySet(existingFields).Difference(sets.KeySet(newFields))
+
+	for property, diff := range validations.SharedPatternProperties(existingFields, newFields) {
+		root := field.NewPath(property)
+		removedFields = removedFields.Union(getRemovedFields(validations.FlattenSchema(diff.Old, root), validations.FlattenSchema(diff.New, root)))
+	}
 
-	return fields
+	return removedFields
 }
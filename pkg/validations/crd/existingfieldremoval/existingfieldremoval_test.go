@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
 	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
 )
 
@@ -174,6 +175,155 @@ func TestExistingFieldRemoval(t *testing.T) {
 			Flagged:              false,
 			ComparableValidation: &ExistingFieldRemoval{},
 		},
+		{
+			Name: "allOf branches reordered, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"fieldOne": {
+											Type: "object",
+											AllOf: []apiextensionsv1.JSONSchemaProps{
+												{Properties: map[string]apiextensionsv1.JSONSchemaProps{"foo": {MinLength: ptr.To[int64](1)}}},
+												{Properties: map[string]apiextensionsv1.JSONSchemaProps{"bar": {MinLength: ptr.To[int64](1)}}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"fieldOne": {
+											Type: "object",
+											AllOf: []apiextensionsv1.JSONSchemaProps{
+												{Properties: map[string]apiextensionsv1.JSONSchemaProps{"bar": {MinLength: ptr.To[int64](1)}}},
+												{Properties: map[string]apiextensionsv1.JSONSchemaProps{"foo": {MinLength: ptr.To[int64](1)}}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &ExistingFieldRemoval{},
+		},
+		{
+			Name: "pattern property pattern rewritten, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"fieldOne": {
+											Type: "object",
+											PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{
+												"^a+$": {Properties: map[string]apiextensionsv1.JSONSchemaProps{"foo": {Type: "string"}}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"fieldOne": {
+											Type: "object",
+											PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{
+												"^(a)+$": {Properties: map[string]apiextensionsv1.JSONSchemaProps{"foo": {Type: "string"}}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &ExistingFieldRemoval{},
+		},
+		{
+			Name: "field of pattern property removed, flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"fieldOne": {
+											Type: "object",
+											PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{
+												"^a+$": {Properties: map[string]apiextensionsv1.JSONSchemaProps{"foo": {Type: "string"}}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"fieldOne": {
+											Type: "object",
+											PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{
+												"^a+$": {Properties: map[string]apiextensionsv1.JSONSchemaProps{"bar": {Type: "string"}}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &ExistingFieldRemoval{},
+		},
 	}
 
 	internaltesting.RunTestcases(t, testcases...)
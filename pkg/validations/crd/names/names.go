@@ -0,0 +1,203 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package names
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+	"sigs.k8s.io/crdify/pkg/validations/property"
+)
+
+var (
+	_ validations.Validation                                           = (*Names)(nil)
+	_ validations.Comparator[apiextensionsv1.CustomResourceDefinition] = (*Names)(nil)
+)
+
+const name = "names"
+
+// Register registers the Names validation
+// with the provided validation registry.
+func Register(registry validations.Registry) {
+	registry.Register(name, factory)
+}
+
+// factory is a function used to initialize a Names validation
+// implementation based on the provided configuration.
+func factory(cfg map[string]interface{}) (validations.Validation, error) {
+	namesCfg := &NamesConfig{}
+
+	err := property.ConfigToType(cfg, namesCfg)
+	if err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	err = ValidateNamesConfig(namesCfg)
+	if err != nil {
+		return nil, fmt.Errorf("validating names config: %w", err)
+	}
+
+	return &Names{NamesConfig: *namesCfg}, nil
+}
+
+// ValidateNamesConfig ensures provided NamesConfig is valid.
+func ValidateNamesConfig(in *NamesConfig) error {
+	if in == nil {
+		return nil
+	}
+
+	switch in.ConvenienceEnforcement {
+	case "", config.EnforcementPolicyError, config.EnforcementPolicyWarn, config.EnforcementPolicyNone:
+		// valid entries
+	default:
+		return fmt.Errorf("%w : %q (valid values: %q, %q, %q)", errUnknownConvenienceEnforcement, in.ConvenienceEnforcement,
+			config.EnforcementPolicyError, config.EnforcementPolicyWarn, config.EnforcementPolicyNone)
+	}
+
+	return nil
+}
+
+var errUnknownConvenienceEnforcement = errors.New("unknown convenience enforcement")
+
+// NamesConfig contains additional configuration for the Names validation.
+type NamesConfig struct {
+	// ConvenienceEnforcement dictates how removing convenience names (the singular name,
+	// listKind, shortNames and categories) is enforced.
+	// Allowed values are Error, Warn and None.
+	// When not set, the enforcement of the Names validation is used.
+	// Changes to the identity of the CRD (its group, kind and plural name) are always enforced as errors.
+	ConvenienceEnforcement config.EnforcementPolicy `json:"convenienceEnforcement,omitempty"`
+}
+
+// Names is a validations.Validation implementation
+// used to check if the group or names have changed
+// from one CRD instance to another.
+type Names struct {
+	NamesConfig
+
+	// enforcement is the EnforcementPolicy that this validation
+	// should use when performing its validation logic
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the Names validation.
+func (n *Names) Name() string {
+	return name
+}
+
+// SetEnforcement sets the EnforcementPolicy for the Names validation.
+func (n *Names) SetEnforcement(enforcement config.EnforcementPolicy) {
+	n.enforcement = enforcement
+}
+
+// Compare compares an old and a new CustomResourceDefinition, checking for changes to the group and names
+// from the old CustomResourceDefinition to the new CustomResourceDefinition.
+// Changes to the identity of the CustomResourceDefinition (its group, kind and plural name) are always
+// reported as errors, regardless of the enforcement of the validation. Removing convenience names (its singular name,
+// listKind, shortNames and categories) is enforced with the configured convenience enforcement, or the enforcement
+// of the validation when it is not configured. Adding convenience names is never flagged.
+func (n *Names) Compare(a, b *apiextensionsv1.CustomResourceDefinition) validations.ComparisonResult {
+	oldNames, newNames := a.Spec.Names, b.Spec.Names
+
+	identityErrs := []error{}
+
+	if a.Spec.Group != b.Spec.Group {
+		identityErrs = append(identityErrs, fmt.Errorf("%w : %q -> %q", ErrGroupChanged, a.Spec.Group, b.Spec.Group))
+	}
+
+	if oldNames.Kind != newNames.Kind {
+		identityErrs = append(identityErrs, fmt.Errorf("%w : %q -> %q", ErrKindChanged, oldNames.Kind, newNames.Kind))
+	}
+
+	if oldNames.Plural != newNames.Plural {
+		identityErrs = append(identityErrs, fmt.Errorf("%w : %q -> %q", ErrPluralChanged, oldNames.Plural, newNames.Plural))
+	}
+
+	convenienceErrs := []error{}
+
+	if oldSingular, newSingular := singularOf(oldNames), singularOf(newNames); oldSingular != newSingular {
+		convenienceErrs = append(convenienceErrs, fmt.Errorf("%w : %q -> %q", ErrSingularChanged, oldSingular, newSingular))
+	}
+
+	if oldListKind, newListKind := listKindOf(oldNames), listKindOf(newNames); oldListKind != newListKind {
+		convenienceErrs = append(convenienceErrs, fmt.Errorf("%w : %q -> %q", ErrListKindChanged, oldListKind, newListKind))
+	}
+
+	if removed := sets.List(sets.New(oldNames.ShortNames...).Difference(sets.New(newNames.ShortNames...))); len(removed) > 0 {
+		convenienceErrs = append(convenienceErrs, fmt.Errorf("%w : %v", ErrShortNamesRemoved, removed))
+	}
+
+	if removed := sets.List(sets.New(oldNames.Categories...).Difference(sets.New(newNames.Categories...))); len(removed) > 0 {
+		convenienceErrs = append(convenienceErrs, fmt.Errorf("%w : %v", ErrCategoriesRemoved, removed))
+	}
+
+	convenienceEnforcement := n.ConvenienceEnforcement
+	if convenienceEnforcement == "" {
+		convenienceEnforcement = n.enforcement
+	}
+
+	result := validations.HandleErrors(n.Name(), config.EnforcementPolicyError, identityErrs...)
+	convenienceResult := validations.HandleErrors(n.Name(), convenienceEnforcement, convenienceErrs...)
+	result.Errors = append(result.Errors, convenienceResult.Errors...)
+	result.Warnings = append(result.Warnings, convenienceResult.Warnings...)
+
+	return result
+}
+
+// singularOf returns the singular name of a CustomResourceDefinition,
+// which the API server defaults to the lowercased kind.
+func singularOf(names apiextensionsv1.CustomResourceDefinitionNames) string {
+	if names.Singular == "" {
+		return strings.ToLower(names.Kind)
+	}
+
+	return names.Singular
+}
+
+// listKindOf returns the listKind of a CustomResourceDefinition,
+// which the API server defaults to the kind suffixed with List.
+func listKindOf(names apiextensionsv1.CustomResourceDefinitionNames) string {
+	if names.ListKind == "" {
+		return names.Kind + "List"
+	}
+
+	return names.ListKind
+}
+
+// ErrGroupChanged represents an error state where the group of the CustomResourceDefinition has changed.
+var ErrGroupChanged = errors.New("group changed")
+
+// ErrKindChanged represents an error state where the kind of the CustomResourceDefinition has changed.
+var ErrKindChanged = errors.New("kind changed")
+
+// ErrPluralChanged represents an error state where the plural name of the CustomResourceDefinition has changed.
+var ErrPluralChanged = errors.New("plural name changed")
+
+// ErrSingularChanged represents an error state where the singular name of the CustomResourceDefinition has changed.
+var ErrSingularChanged = errors.New("singular name changed")
+
+// ErrListKindChanged represents an error state where the listKind of the CustomResourceDefinition has changed.
+var ErrListKindChanged = errors.New("list kind changed")
+
+// ErrShortNamesRemoved represents an error state where short names of the CustomResourceDefinition were removed.
+var ErrShortNamesRemoved = errors.New("short names removed")
+
+// ErrCategoriesRemoved represents an error state where categories of the CustomResourceDefinition were removed.
+var ErrCategoriesRemoved = errors.New("categories removed")
@@ -0,0 +1,417 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package names
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/crdify/pkg/config"
+	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
+)
+
+func TestNames(t *testing.T) {
+	testcases := []internaltesting.Testcase[apiextensionsv1.CustomResourceDefinition]{
+		{
+			Name: "no names change, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Group: "example.com",
+					Names: apiextensionsv1.CustomResourceDefinitionNames{
+						Kind:       "Widget",
+						ListKind:   "WidgetList",
+						Plural:     "widgets",
+						Singular:   "widget",
+						ShortNames: []string{"wd", "wdg"},
+						Categories: []string{"all", "gadgets"},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Group: "example.com",
+					Names: apiextensionsv1.CustomResourceDefinitionNames{
+						Kind:       "Widget",
+						ListKind:   "WidgetList",
+						Plural:     "widgets",
+						Singular:   "widget",
+						ShortNames: []string{"wd", "wdg"},
+						Categories: []string{"all", "gadgets"},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &Names{},
+		},
+		{
+			Name: "defaulted singular and listKind set explicitly, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Group: "example.com",
+					Names: apiextensionsv1.CustomResourceDefinitionNames{
+						Kind:   "Widget",
+						Plural: "widgets",
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Group: "example.com",
+					Names: apiextensionsv1.CustomResourceDefinitionNames{
+						Kind:     "Widget",
+						ListKind: "WidgetList",
+						Plural:   "widgets",
+						Singular: "widget",
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &Names{},
+		},
+		{
+			Name: "short name removed, flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Group: "example.com",
+					Names: apiextensionsv1.CustomResourceDefinitionNames{
+						Kind:       "Widget",
+						ListKind:   "WidgetList",
+						Plural:     "widgets",
+						Singular:   "widget",
+						ShortNames: []string{"wd", "wdg"},
+						Categories: []string{"all", "gadgets"},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Group: "example.com",
+					Names: apiextensionsv1.CustomResourceDefinitionNames{
+						Kind:       "Widget",
+						ListKind:   "WidgetList",
+						Plural:     "widgets",
+						Singular:   "widget",
+						ShortNames: []string{"wd"},
+						Categories: []string{"all", "gadgets"},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &Names{},
+		},
+		{
+			Name: "short names and categories added, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Group: "example.com",
+					Names: apiextensionsv1.CustomResourceDefinitionNames{
+						Kind:       "Widget",
+						ListKind:   "WidgetList",
+						Plural:     "widgets",
+						Singular:   "widget",
+						ShortNames: []string{"wd", "wdg"},
+						Categories: []string{"all", "gadgets"},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Group: "example.com",
+					Names: apiextensionsv1.CustomResourceDefinitionNames{
+						Kind:       "Widget",
+						ListKind:   "WidgetList",
+						Plural:     "widgets",
+						Singular:   "widget",
+						ShortNames: []string{"wd", "wdg", "w"},
+						Categories: []string{"all", "gadgets", "tools"},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &Names{},
+		},
+		{
+			Name: "category removed, convenience enforcement set to None, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Group: "example.com",
+					Names: apiextensionsv1.CustomResourceDefinitionNames{
+						Kind:       "Widget",
+						ListKind:   "WidgetList",
+						Plural:     "widgets",
+						Singular:   "widget",
+						ShortNames: []string{"wd", "wdg"},
+						Categories: []string{"all", "gadgets"},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Group: "example.com",
+					Names: apiextensionsv1.CustomResourceDefinitionNames{
+						Kind:       "Widget",
+						ListKind:   "WidgetList",
+						Plural:     "widgets",
+						Singular:   "widget",
+						ShortNames: []string{"wd", "wdg"},
+						Categories: []string{"gadgets"},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &Names{NamesConfig: NamesConfig{ConvenienceEnforcement: config.EnforcementPolicyNone}},
+		},
+	}
+
+	internaltesting.RunTestcases(t, testcases...)
+}
+
+func TestNamesIdentityChangesAlwaysError(t *testing.T) {
+	testcases := []struct {
+		name     string
+		old      *apiextensionsv1.CustomResourceDefinition
+		new      *apiextensionsv1.CustomResourceDefinition
+		expected []string
+	}{
+		{
+			name: "group changed",
+			old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Group: "example.com",
+					Names: apiextensionsv1.CustomResourceDefinitionNames{
+						Kind:       "Widget",
+						ListKind:   "WidgetList",
+						Plural:     "widgets",
+						Singular:   "widget",
+						ShortNames: []string{"wd", "wdg"},
+						Categories: []string{"all", "gadgets"},
+					},
+				},
+			},
+			new: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Group: "example.io",
+					Names: apiextensionsv1.CustomResourceDefinitionNames{
+						Kind:       "Widget",
+						ListKind:   "WidgetList",
+						Plural:     "widgets",
+						Singular:   "widget",
+						ShortNames: []string{"wd", "wdg"},
+						Categories: []string{"all", "gadgets"},
+					},
+				},
+			},
+			expected: []string{`group changed : "example.com" -> "example.io"`},
+		},
+		{
+			name: "kind changed",
+			old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Group: "example.com",
+					Names: apiextensionsv1.CustomResourceDefinitionNames{
+						Kind:       "Widget",
+						ListKind:   "WidgetList",
+						Plural:     "widgets",
+						Singular:   "widget",
+						ShortNames: []string{"wd", "wdg"},
+						Categories: []string{"all", "gadgets"},
+					},
+				},
+			},
+			new: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Group: "example.com",
+					Names: apiextensionsv1.CustomResourceDefinitionNames{
+						Kind:       "Gadget",
+						ListKind:   "WidgetList",
+						Plural:     "widgets",
+						Singular:   "widget",
+						ShortNames: []string{"wd", "wdg"},
+						Categories: []string{"all", "gadgets"},
+					},
+				},
+			},
+			expected: []string{`kind changed : "Widget" -> "Gadget"`},
+		},
+		{
+			name: "plural changed",
+			old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Group: "example.com",
+					Names: apiextensionsv1.CustomResourceDefinitionNames{
+						Kind:       "Widget",
+						ListKind:   "WidgetList",
+						Plural:     "widgets",
+						Singular:   "widget",
+						ShortNames: []string{"wd", "wdg"},
+						Categories: []string{"all", "gadgets"},
+					},
+				},
+			},
+			new: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Group: "example.com",
+					Names: apiextensionsv1.CustomResourceDefinitionNames{
+						Kind:       "Widget",
+						ListKind:   "WidgetList",
+						Plural:     "widgetz",
+						Singular:   "widget",
+						ShortNames: []string{"wd", "wdg"},
+						Categories: []string{"all", "gadgets"},
+					},
+				},
+			},
+			expected: []string{`plural name changed : "widgets" -> "widgetz"`},
+		},
+	}
+
+	for _, tc := range testcases {
+		for _, enforcement := range []config.EnforcementPolicy{config.EnforcementPolicyError, config.EnforcementPolicyWarn, config.EnforcementPolicyNone} {
+			t.Run(tc.name+" with enforcement policy "+string(enforcement), func(t *testing.T) {
+				val := &Names{}
+				val.SetEnforcement(enforcement)
+
+				result := val.Compare(tc.old, tc.new)
+				if !slices.Equal(result.Errors, tc.expected) {
+					t.Fatalf("expected errors %q, got %q", tc.expected, result.Errors)
+				}
+			})
+		}
+	}
+}
+
+func TestNamesConvenienceEnforcement(t *testing.T) {
+	old := &apiextensionsv1.CustomResourceDefinition{
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "example.com",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Kind:       "Widget",
+				ListKind:   "WidgetList",
+				Plural:     "widgets",
+				Singular:   "widget",
+				ShortNames: []string{"wd", "wdg"},
+				Categories: []string{"all", "gadgets"},
+			},
+		},
+	}
+	new := &apiextensionsv1.CustomResourceDefinition{
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "example.com",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Kind:       "Gadget",
+				ListKind:   "GadgetList",
+				Plural:     "widgets",
+				Singular:   "widget",
+				Categories: []string{"gadgets"},
+			},
+		},
+	}
+
+	val := &Names{NamesConfig: NamesConfig{ConvenienceEnforcement: config.EnforcementPolicyWarn}}
+	val.SetEnforcement(config.EnforcementPolicyError)
+
+	result := val.Compare(old, new)
+
+	expectedErrors := []string{`kind changed : "Widget" -> "Gadget"`}
+	if !slices.Equal(result.Errors, expectedErrors) {
+		t.Fatalf("expected errors %q, got %q", expectedErrors, result.Errors)
+	}
+
+	expectedWarnings := []string{
+		`list kind changed : "WidgetList" -> "GadgetList"`,
+		`short names removed : [wd wdg]`,
+		`categories removed : [all]`,
+	}
+	if !slices.Equal(result.Warnings, expectedWarnings) {
+		t.Fatalf("expected warnings %q, got %q", expectedWarnings, result.Warnings)
+	}
+}
+
+func TestNamesConvenienceEnforcementIndependentOfEnforcement(t *testing.T) {
+	old := &apiextensionsv1.CustomResourceDefinition{
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "example.com",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Kind:       "Widget",
+				ListKind:   "WidgetList",
+				Plural:     "widgets",
+				Singular:   "widget",
+				ShortNames: []string{"wd", "wdg"},
+				Categories: []string{"all", "gadgets"},
+			},
+		},
+	}
+	new := &apiextensionsv1.CustomResourceDefinition{
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "example.com",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Kind:       "Widget",
+				ListKind:   "WidgetList",
+				Plural:     "widgets",
+				Singular:   "widget",
+				ShortNames: []string{"wd"},
+				Categories: []string{"all", "gadgets"},
+			},
+		},
+	}
+
+	val := &Names{NamesConfig: NamesConfig{ConvenienceEnforcement: config.EnforcementPolicyError}}
+	val.SetEnforcement(config.EnforcementPolicyNone)
+
+	result := val.Compare(old, new)
+
+	expectedErrors := []string{`short names removed : [wdg]`}
+	if !slices.Equal(result.Errors, expectedErrors) {
+		t.Fatalf("expected errors %q, got %q", expectedErrors, result.Errors)
+	}
+}
+
+func TestValidateNamesConfig(t *testing.T) {
+	testcases := []struct {
+		name    string
+		cfg     *NamesConfig
+		wantErr error
+	}{
+		{
+			name: "nil config",
+			cfg:  nil,
+		},
+		{
+			name: "convenience enforcement not set",
+			cfg:  &NamesConfig{},
+		},
+		{
+			name: "valid convenience enforcement",
+			cfg:  &NamesConfig{ConvenienceEnforcement: config.EnforcementPolicyWarn},
+		},
+		{
+			name:    "invalid convenience enforcement",
+			cfg:     &NamesConfig{ConvenienceEnforcement: "invalid"},
+			wantErr: errUnknownConvenienceEnforcement,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateNamesConfig(tc.cfg)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
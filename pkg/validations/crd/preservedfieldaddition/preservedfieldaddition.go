@@ -0,0 +1,137 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preservedfieldaddition
+
+import (
+	"errors"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+)
+
+var (
+	_ validations.Validation                                           = (*PreservedFieldAddition)(nil)
+	_ validations.Comparator[apiextensionsv1.CustomResourceDefinition] = (*PreservedFieldAddition)(nil)
+)
+
+const name = "preservedFieldAddition"
+
+// Register registers the PreservedFieldAddition validation
+// with the provided validation registry.
+func Register(registry validations.Registry) {
+	registry.Register(name, factory)
+}
+
+// factory is a function used to initialize a PreservedFieldAddition validation
+// implementation based on the provided configuration.
+func factory(_ map[string]interface{}) (validations.Validation, error) {
+	return &PreservedFieldAddition{}, nil
+}
+
+// PreservedFieldAddition is a validations.Validation implementation
+// used to check if any properties have been added in a place where
+// the old CRD instance preserved unknown fields, meaning existing
+// objects may already hold arbitrary data for the new properties.
+type PreservedFieldAddition struct {
+	// enforcement is the EnforcementPolicy that this validation
+	// should use when performing its validation logic
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the PreservedFieldAddition validation.
+func (pfa *PreservedFieldAddition) Name() string {
+	return name
+}
+
+// SetEnforcement sets the EnforcementPolicy for the PreservedFieldAddition validation.
+func (pfa *PreservedFieldAddition) SetEnforcement(policy config.EnforcementPolicy) {
+	pfa.enforcement = policy
+}
+
+// Compare compares an old and a new CustomResourceDefinition, checking for any properties that only exist
+// in the new CustomResourceDefinition and are nested under a property that preserves unknown fields in the old
+// CustomResourceDefinition. Existing objects may already store data that does not match the schema of the
+// new properties, so these are always reported as warnings.
+func (pfa *PreservedFieldAddition) Compare(a, b *apiextensionsv1.CustomResourceDefinition) validations.ComparisonResult {
+	warns := []error{}
+
+	for _, newVersion := range b.Spec.Versions {
+		existingVersion := validations.GetCRDVersionByName(a, newVersion.Name)
+		if existingVersion == nil || existingVersion.Schema == nil || newVersion.Schema == nil {
+			continue
+		}
+
+		added := map[string]string{}
+		addedUnderPreservedFields(existingVersion.Schema.OpenAPIV3Schema, newVersion.Schema.OpenAPIV3Schema, field.NewPath("^"), nil, added)
+
+		for _, addedField := range sets.List(sets.KeySet(added)) {
+			warns = append(warns, fmt.Errorf("%w : %v.%v : unknown fields are preserved by %v.%v so existing objects may already store data for this property that does not match its schema",
+				ErrPreservedFieldAdded, newVersion.Name, addedField, newVersion.Name, added[addedField]))
+		}
+	}
+
+	return validations.HandleErrorsAndWarnings(pfa.Name(), pfa.enforcement, nil, warns)
+}
+
+// ErrPreservedFieldAdded represents a state where a property was added under
+// a property that preserved unknown fields.
+var ErrPreservedFieldAdded = errors.New("property added where unknown fields were preserved")
+
+// addedUnderPreservedFields walks the old and new schemas in parallel and records, in added,
+// the path of each property that only exists in the new schema and whose ancestry in the old
+// schema preserves unknown fields, along with the path of the closest ancestor that does.
+// Specified objects that don't preserve unknown fields themselves prune them, so an ancestor
+// preserving unknown fields does not apply to properties added below them.
+// Only the outermost added property is recorded, as its descendants are added along with it.
+func addedUnderPreservedFields(oldSchema, newSchema *apiextensionsv1.JSONSchemaProps, fldPath *field.Path, preservedBy *field.Path, added map[string]string) {
+	if oldSchema == nil || newSchema == nil {
+		return
+	}
+
+	switch {
+	case ptr.Deref(oldSchema.XPreserveUnknownFields, false):
+		preservedBy = fldPath
+	case oldSchema.Type == "object" || len(oldSchema.Properties) > 0:
+		preservedBy = nil
+	}
+
+	for _, propertyName := range sets.List(sets.KeySet(newSchema.Properties)) {
+		newProperty := newSchema.Properties[propertyName]
+
+		oldProperty, ok := oldSchema.Properties[propertyName]
+		if !ok {
+			if preservedBy != nil {
+				added[fldPath.Child(propertyName).String()] = preservedBy.String()
+			}
+
+			continue
+		}
+
+		addedUnderPreservedFields(&oldProperty, &newProperty, fldPath.Child(propertyName), preservedBy, added)
+	}
+
+	if oldSchema.Items != nil && newSchema.Items != nil {
+		addedUnderPreservedFields(oldSchema.Items.Schema, newSchema.Items.Schema, fldPath.Child("items"), preservedBy, added)
+	}
+
+	if oldSchema.AdditionalProperties != nil && newSchema.AdditionalProperties != nil {
+		addedUnderPreservedFields(oldSchema.AdditionalProperties.Schema, newSchema.AdditionalProperties.Schema, fldPath.Child("additionalProperties"), preservedBy, added)
+	}
+}
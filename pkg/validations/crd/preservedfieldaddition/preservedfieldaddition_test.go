@@ -0,0 +1,374 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preservedfieldaddition
+
+import (
+	"slices"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/crdify/pkg/config"
+)
+
+func TestPreservedFieldAddition(t *testing.T) {
+	testcases := []struct {
+		name         string
+		old          *apiextensionsv1.CustomResourceDefinition
+		new          *apiextensionsv1.CustomResourceDefinition
+		wantWarnings []string
+	}{
+		{
+			name: "property added without preserved unknown fields, no warnings",
+			old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			new: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type: "object",
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"foo": {Type: "string"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "property added where unknown fields were preserved, warned",
+			old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type:                   "object",
+											XPreserveUnknownFields: ptr.To(true),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			new: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type:                   "object",
+											XPreserveUnknownFields: ptr.To(true),
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"foo": {
+													Type: "object",
+													Properties: map[string]apiextensionsv1.JSONSchemaProps{
+														"bar": {Type: "string"},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantWarnings: []string{
+				"property added where unknown fields were preserved : v1alpha1.^.spec.foo : unknown fields are preserved by v1alpha1.^.spec so existing objects may already store data for this property that does not match its schema",
+			},
+		},
+		{
+			name: "property added below a specified object that does not preserve unknown fields, no warnings",
+			old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type:                   "object",
+											XPreserveUnknownFields: ptr.To(true),
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"list": {
+													Type: "array",
+													Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+														Schema: &apiextensionsv1.JSONSchemaProps{Type: "object"},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			new: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type:                   "object",
+											XPreserveUnknownFields: ptr.To(true),
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"list": {
+													Type: "array",
+													Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+														Schema: &apiextensionsv1.JSONSchemaProps{
+															Type: "object",
+															Properties: map[string]apiextensionsv1.JSONSchemaProps{
+																"name": {Type: "string"},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "property added below a specified object that preserves unknown fields, warned",
+			old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type:                   "object",
+											XPreserveUnknownFields: ptr.To(true),
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"list": {
+													Type: "array",
+													Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+														Schema: &apiextensionsv1.JSONSchemaProps{
+															Type:                   "object",
+															XPreserveUnknownFields: ptr.To(true),
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			new: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type:                   "object",
+											XPreserveUnknownFields: ptr.To(true),
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"list": {
+													Type: "array",
+													Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+														Schema: &apiextensionsv1.JSONSchemaProps{
+															Type:                   "object",
+															XPreserveUnknownFields: ptr.To(true),
+															Properties: map[string]apiextensionsv1.JSONSchemaProps{
+																"name": {Type: "string"},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantWarnings: []string{
+				"property added where unknown fields were preserved : v1alpha1.^.spec.list.items.name : unknown fields are preserved by v1alpha1.^.spec.list.items so existing objects may already store data for this property that does not match its schema",
+			},
+		},
+		{
+			name: "unknown fields only preserved by the new schema, no warnings",
+			old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			new: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type:                   "object",
+											XPreserveUnknownFields: ptr.To(true),
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"foo": {Type: "string"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "existing property under preserved unknown fields, no warnings",
+			old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type:                   "object",
+											XPreserveUnknownFields: ptr.To(true),
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"foo": {Type: "string"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			new: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type:                   "object",
+											XPreserveUnknownFields: ptr.To(true),
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"foo": {Type: "string"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			val := &PreservedFieldAddition{}
+			val.SetEnforcement(config.EnforcementPolicyError)
+
+			result := val.Compare(tc.old, tc.new)
+
+			if len(result.Errors) > 0 {
+				t.Fatalf("expected no errors, got %v", result.Errors)
+			}
+
+			if !slices.Equal(result.Warnings, tc.wantWarnings) {
+				t.Fatalf("expected warnings %q, got %q", tc.wantWarnings, result.Warnings)
+			}
+		})
+	}
+}
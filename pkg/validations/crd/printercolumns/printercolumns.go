@@ -0,0 +1,216 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printercolumns
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+)
+
+var (
+	_ validations.Validation                                           = (*PrinterColumns)(nil)
+	_ validations.Comparator[apiextensionsv1.CustomResourceDefinition] = (*PrinterColumns)(nil)
+)
+
+const name = "printerColumns"
+
+// Register registers the PrinterColumns validation
+// with the provided validation registry.
+func Register(registry validations.Registry) {
+	registry.Register(name, factory)
+}
+
+// factory is a function used to initialize a PrinterColumns validation
+// implementation based on the provided configuration.
+func factory(_ map[string]interface{}) (validations.Validation, error) {
+	return &PrinterColumns{}, nil
+}
+
+// PrinterColumns is a validations.Validation implementation
+// used to check if the additional printer columns or selectable
+// fields of any version have changed from one CRD instance to another.
+type PrinterColumns struct {
+	// enforcement is the EnforcementPolicy that this validation
+	// should use when performing its validation logic
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the PrinterColumns validation.
+func (pc *PrinterColumns) Name() string {
+	return name
+}
+
+// SetEnforcement sets the EnforcementPolicy for the PrinterColumns validation.
+func (pc *PrinterColumns) SetEnforcement(enforcement config.EnforcementPolicy) {
+	pc.enforcement = enforcement
+}
+
+// Compare compares an old and a new CustomResourceDefinition, checking each version for additional printer columns
+// that were removed, renamed or had their type or JSONPath changed and for selectable fields that were removed.
+// Additional printer columns and selectable fields whose JSONPath pointed at a property of the old schema
+// of a version that no longer exists in the new schema of that version are also flagged.
+func (pc *PrinterColumns) Compare(a, b *apiextensionsv1.CustomResourceDefinition) validations.ComparisonResult {
+	errs := []error{}
+
+	for _, newVersion := range b.Spec.Versions {
+		existingVersion := validations.GetCRDVersionByName(a, newVersion.Name)
+		if existingVersion == nil {
+			continue
+		}
+
+		errs = append(errs, comparePrinterColumns(newVersion.Name, existingVersion.AdditionalPrinterColumns, newVersion.AdditionalPrinterColumns)...)
+		errs = append(errs, compareSelectableFields(newVersion.Name, existingVersion.SelectableFields, newVersion.SelectableFields)...)
+		errs = append(errs, checkDanglingPaths(existingVersion, &newVersion)...)
+	}
+
+	return validations.HandleErrors(pc.Name(), pc.enforcement, errs...)
+}
+
+// comparePrinterColumns compares the old and new additional printer columns of a version.
+// Columns are matched by name. A removed column and an added column with the same JSONPath
+// are treated as a renamed column.
+func comparePrinterColumns(version string, oldColumns, newColumns []apiextensionsv1.CustomResourceColumnDefinition) []error {
+	errs := []error{}
+
+	newByName := map[string]apiextensionsv1.CustomResourceColumnDefinition{}
+	for _, column := range newColumns {
+		newByName[column.Name] = column
+	}
+
+	oldNames := sets.New[string]()
+	for _, column := range oldColumns {
+		oldNames.Insert(column.Name)
+	}
+
+	for _, oldColumn := range oldColumns {
+		newColumn, ok := newByName[oldColumn.Name]
+		if !ok {
+			errs = append(errs, removedOrRenamedColumn(version, oldColumn, newColumns, oldNames))
+			continue
+		}
+
+		if oldColumn.Type != newColumn.Type {
+			errs = append(errs, fmt.Errorf("%w : %v : %q : %q -> %q", ErrPrinterColumnTypeChanged, version, oldColumn.Name, oldColumn.Type, newColumn.Type))
+		}
+
+		if oldColumn.JSONPath != newColumn.JSONPath {
+			errs = append(errs, fmt.Errorf("%w : %v : %q : %q -> %q", ErrPrinterColumnJSONPathChanged, version, oldColumn.Name, oldColumn.JSONPath, newColumn.JSONPath))
+		}
+	}
+
+	return errs
+}
+
+// removedOrRenamedColumn returns the error for an old column that no longer exists by name,
+// reporting it as renamed when a new column with a name that did not exist before has the same JSONPath.
+func removedOrRenamedColumn(version string, oldColumn apiextensionsv1.CustomResourceColumnDefinition, newColumns []apiextensionsv1.CustomResourceColumnDefinition, oldNames sets.Set[string]) error {
+	for _, newColumn := range newColumns {
+		if !oldNames.Has(newColumn.Name) && newColumn.JSONPath == oldColumn.JSONPath {
+			return fmt.Errorf("%w : %v : %q -> %q", ErrPrinterColumnRenamed, version, oldColumn.Name, newColumn.Name)
+		}
+	}
+
+	return fmt.Errorf("%w : %v : %q", ErrPrinterColumnRemoved, version, oldColumn.Name)
+}
+
+// compareSelectableFields compares the old and new selectable fields of a version.
+func compareSelectableFields(version string, oldFields, newFields []apiextensionsv1.SelectableField) []error {
+	oldPaths := sets.New[string]()
+	for _, selectableField := range oldFields {
+		oldPaths.Insert(selectableField.JSONPath)
+	}
+
+	newPaths := sets.New[string]()
+	for _, selectableField := range newFields {
+		newPaths.Insert(selectableField.JSONPath)
+	}
+
+	if removed := sets.List(oldPaths.Difference(newPaths)); len(removed) > 0 {
+		return []error{fmt.Errorf("%w : %v : %v", ErrSelectableFieldRemoved, version, removed)}
+	}
+
+	return nil
+}
+
+// checkDanglingPaths checks that the JSONPaths of the additional printer columns and selectable fields
+// of the new version still point at a property of the new schema when they pointed at a property of the old schema.
+func checkDanglingPaths(oldVersion, newVersion *apiextensionsv1.CustomResourceDefinitionVersion) []error {
+	if oldVersion.Schema == nil || newVersion.Schema == nil {
+		return nil
+	}
+
+	oldProperties := validations.FlattenCRDVersion(*oldVersion)
+	newProperties := validations.FlattenCRDVersion(*newVersion)
+
+	dangling := func(jsonPath string) bool {
+		property := propertyPath(jsonPath)
+		_, existed := oldProperties[property]
+		_, exists := newProperties[property]
+
+		return existed && !exists
+	}
+
+	errs := []error{}
+
+	for _, column := range newVersion.AdditionalPrinterColumns {
+		if dangling(column.JSONPath) {
+			errs = append(errs, fmt.Errorf("%w : %v : column %q : %q no longer exists in the schema", ErrDanglingJSONPath, newVersion.Name, column.Name, column.JSONPath))
+		}
+	}
+
+	for _, selectableField := range newVersion.SelectableFields {
+		if dangling(selectableField.JSONPath) {
+			errs = append(errs, fmt.Errorf("%w : %v : selectable field %q no longer exists in the schema", ErrDanglingJSONPath, newVersion.Name, selectableField.JSONPath))
+		}
+	}
+
+	return errs
+}
+
+// arrayIndexRegexp matches the array notation of a JSONPath (i.e [0] or [*]).
+var arrayIndexRegexp = regexp.MustCompile(`\[[^\]]*\]`)
+
+// propertyPath converts a simple JSONPath (i.e .status.conditions[0].type) to
+// the path of the property it points at, as used as the keys of FlattenCRDVersion
+// (i.e ^.status.conditions.items.type).
+func propertyPath(jsonPath string) string {
+	return "^" + strings.TrimSuffix(arrayIndexRegexp.ReplaceAllString(jsonPath, ".items"), ".")
+}
+
+// ErrPrinterColumnRemoved represents an error state where an additional printer column was removed from a version.
+var ErrPrinterColumnRemoved = errors.New("printer column removed")
+
+// ErrPrinterColumnRenamed represents an error state where an additional printer column of a version was renamed.
+var ErrPrinterColumnRenamed = errors.New("printer column renamed")
+
+// ErrPrinterColumnTypeChanged represents an error state where the type of an additional printer column of a version changed.
+var ErrPrinterColumnTypeChanged = errors.New("printer column type changed")
+
+// ErrPrinterColumnJSONPathChanged represents an error state where the JSONPath of an additional printer column of a version changed.
+var ErrPrinterColumnJSONPathChanged = errors.New("printer column jsonPath changed")
+
+// ErrSelectableFieldRemoved represents an error state where selectable fields were removed from a version.
+var ErrSelectableFieldRemoved = errors.New("selectable fields removed")
+
+// ErrDanglingJSONPath represents an error state where the JSONPath of an additional printer column or
+// selectable field points at a property that no longer exists in the schema of a version.
+var ErrDanglingJSONPath = errors.New("jsonPath points at a removed property")
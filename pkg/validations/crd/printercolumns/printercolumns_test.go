@@ -0,0 +1,535 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printercolumns
+
+import (
+	"slices"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/crdify/pkg/config"
+	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
+)
+
+func TestPrinterColumns(t *testing.T) {
+	testcases := []internaltesting.Testcase[apiextensionsv1.CustomResourceDefinition]{
+		{
+			Name: "no columns changed, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type: "object",
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"color": {Type: "string"},
+											},
+										},
+									},
+								},
+							},
+							AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+								{
+									Name:     "Color",
+									Type:     "string",
+									JSONPath: ".spec.color",
+								},
+							},
+							SelectableFields: []apiextensionsv1.SelectableField{
+								{JSONPath: ".spec.color"},
+							},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type: "object",
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"color": {Type: "string"},
+											},
+										},
+									},
+								},
+							},
+							AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+								{
+									Name:     "Color",
+									Type:     "string",
+									JSONPath: ".spec.color",
+								},
+							},
+							SelectableFields: []apiextensionsv1.SelectableField{
+								{JSONPath: ".spec.color"},
+							},
+						},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &PrinterColumns{},
+		},
+		{
+			Name: "column and selectable field added, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type: "object",
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"color": {Type: "string"},
+												"size":  {Type: "string"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type: "object",
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"color": {Type: "string"},
+												"size":  {Type: "string"},
+											},
+										},
+									},
+								},
+							},
+							AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+								{
+									Name:     "Color",
+									Type:     "string",
+									JSONPath: ".spec.color",
+								},
+							},
+							SelectableFields: []apiextensionsv1.SelectableField{
+								{JSONPath: ".spec.size"},
+							},
+						},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &PrinterColumns{},
+		},
+		{
+			Name: "column removed, flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type: "object",
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"color": {Type: "string"},
+											},
+										},
+									},
+								},
+							},
+							AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+								{
+									Name:     "Color",
+									Type:     "string",
+									JSONPath: ".spec.color",
+								},
+							},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type: "object",
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"color": {Type: "string"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &PrinterColumns{},
+		},
+		{
+			Name: "column type changed, flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type: "object",
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"color": {Type: "string"},
+											},
+										},
+									},
+								},
+							},
+							AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+								{
+									Name:     "Color",
+									Type:     "string",
+									JSONPath: ".spec.color",
+								},
+							},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type: "object",
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"color": {Type: "string"},
+											},
+										},
+									},
+								},
+							},
+							AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+								{
+									Name:     "Color",
+									Type:     "integer",
+									JSONPath: ".spec.color",
+								},
+							},
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &PrinterColumns{},
+		},
+		{
+			Name: "selectable field removed, flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type: "object",
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"color": {Type: "string"},
+											},
+										},
+									},
+								},
+							},
+							SelectableFields: []apiextensionsv1.SelectableField{
+								{JSONPath: ".spec.color"},
+							},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type: "object",
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"color": {Type: "string"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &PrinterColumns{},
+		},
+		{
+			Name: "selectable field points at a removed property, flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {
+											Type: "object",
+											Properties: map[string]apiextensionsv1.JSONSchemaProps{
+												"color": {Type: "string"},
+											},
+										},
+									},
+								},
+							},
+							SelectableFields: []apiextensionsv1.SelectableField{
+								{JSONPath: ".spec.color"},
+							},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+							SelectableFields: []apiextensionsv1.SelectableField{
+								{JSONPath: ".spec.color"},
+							},
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &PrinterColumns{},
+		},
+		{
+			Name: "column points at a property that never existed, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+							AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+								{
+									Name:     "Age",
+									Type:     "date",
+									JSONPath: ".metadata.creationTimestamp",
+								},
+							},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1",
+							Schema: &apiextensionsv1.CustomResourceValidation{
+								OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"spec": {Type: "object"},
+									},
+								},
+							},
+							AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+								{
+									Name:     "Age",
+									Type:     "date",
+									JSONPath: ".metadata.creationTimestamp",
+								},
+							},
+						},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &PrinterColumns{},
+		},
+	}
+
+	internaltesting.RunTestcases(t, testcases...)
+}
+
+func TestPrinterColumnsRenamedAndDangling(t *testing.T) {
+	val := &PrinterColumns{}
+	val.SetEnforcement(config.EnforcementPolicyError)
+
+	result := val.Compare(
+		&apiextensionsv1.CustomResourceDefinition{
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{
+						Name: "v1",
+						Schema: &apiextensionsv1.CustomResourceValidation{
+							OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+								Type: "object",
+								Properties: map[string]apiextensionsv1.JSONSchemaProps{
+									"spec": {
+										Type: "object",
+										Properties: map[string]apiextensionsv1.JSONSchemaProps{
+											"color": {Type: "string"},
+											"size":  {Type: "string"},
+										},
+									},
+								},
+							},
+						},
+						AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+							{
+								Name:     "Color",
+								Type:     "string",
+								JSONPath: ".spec.color",
+							},
+							{
+								Name:     "Size",
+								Type:     "string",
+								JSONPath: ".spec.size",
+							},
+						},
+					},
+				},
+			},
+		},
+		&apiextensionsv1.CustomResourceDefinition{
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{
+						Name: "v1",
+						Schema: &apiextensionsv1.CustomResourceValidation{
+							OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+								Type: "object",
+								Properties: map[string]apiextensionsv1.JSONSchemaProps{
+									"spec": {
+										Type: "object",
+										Properties: map[string]apiextensionsv1.JSONSchemaProps{
+											"color": {Type: "string"},
+										},
+									},
+								},
+							},
+						},
+						AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+							{
+								Name:     "Colour",
+								Type:     "string",
+								JSONPath: ".spec.color",
+							},
+							{
+								Name:     "Size",
+								Type:     "string",
+								JSONPath: ".spec.size",
+							},
+						},
+					},
+				},
+			},
+		},
+	)
+
+	expected := []string{
+		`printer column renamed : v1 : "Color" -> "Colour"`,
+		`jsonPath points at a removed property : v1 : column "Size" : ".spec.size" no longer exists in the schema`,
+	}
+
+	if !slices.Equal(result.Errors, expected) {
+		t.Fatalf("expected errors %q, got %q", expected, result.Errors)
+	}
+}
+
+func TestPropertyPath(t *testing.T) {
+	testcases := map[string]string{
+		".spec.replicas":               "^.spec.replicas",
+		".status.conditions[0].type":   "^.status.conditions.items.type",
+		".status.conditions[*].status": "^.status.conditions.items.status",
+	}
+
+	for jsonPath, want := range testcases {
+		if got := propertyPath(jsonPath); got != want {
+			t.Fatalf("expected %q to resolve to %q, got %q", jsonPath, want, got)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subresources
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+)
+
+var (
+	_ validations.Validation                                           = (*Subresources)(nil)
+	_ validations.Comparator[apiextensionsv1.CustomResourceDefinition] = (*Subresources)(nil)
+)
+
+const name = "subresources"
+
+// Register registers the Subresources validation
+// with the provided validation registry.
+func Register(registry validations.Registry) {
+	registry.Register(name, factory)
+}
+
+// factory is a function used to initialize a Subresources validation
+// implementation based on the provided configuration.
+func factory(_ map[string]interface{}) (validations.Validation, error) {
+	return &Subresources{}, nil
+}
+
+// Subresources is a validations.Validation implementation
+// used to check if the status and scale subresources of any
+// version have changed from one CRD instance to another.
+type Subresources struct {
+	// enforcement is the EnforcementPolicy that this validation
+	// should use when performing its validation logic
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the Subresources validation.
+func (s *Subresources) Name() string {
+	return name
+}
+
+// SetEnforcement sets the EnforcementPolicy for the Subresources validation.
+func (s *Subresources) SetEnforcement(enforcement config.EnforcementPolicy) {
+	s.enforcement = enforcement
+}
+
+// Compare compares an old and a new CustomResourceDefinition, checking each version for the status subresource
+// being added or removed and for the scale subresource being removed or having its paths changed.
+// The scale paths of every version of the new CustomResourceDefinition are also checked to resolve to
+// properties of the expected type in the schema of that version.
+func (s *Subresources) Compare(a, b *apiextensionsv1.CustomResourceDefinition) validations.ComparisonResult {
+	errs := []error{}
+
+	for _, newVersion := range b.Spec.Versions {
+		if existingVersion := validations.GetCRDVersionByName(a, newVersion.Name); existingVersion != nil {
+			errs = append(errs, compareSubresources(newVersion.Name, existingVersion.Subresources, newVersion.Subresources)...)
+		}
+
+		errs = append(errs, checkScalePaths(&newVersion)...)
+	}
+
+	return validations.HandleErrors(s.Name(), s.enforcement, errs...)
+}
+
+// compareSubresources compares the old and new subresources of a version.
+func compareSubresources(version string, oldSubresources, newSubresources *apiextensionsv1.CustomResourceSubresources) []error {
+	if oldSubresources == nil {
+		oldSubresources = &apiextensionsv1.CustomResourceSubresources{}
+	}
+
+	if newSubresources == nil {
+		newSubresources = &apiextensionsv1.CustomResourceSubresources{}
+	}
+
+	errs := []error{}
+
+	switch {
+	case oldSubresources.Status == nil && newSubresources.Status != nil:
+		errs = append(errs, fmt.Errorf("%w : %v : writes to the main resource can no longer modify .status, it can only be modified through the status subresource", ErrStatusSubresourceAdded, version))
+	case oldSubresources.Status != nil && newSubresources.Status == nil:
+		errs = append(errs, fmt.Errorf("%w : %v : writes to the main resource can now modify .status and the status subresource is no longer available", ErrStatusSubresourceRemoved, version))
+	}
+
+	oldScale, newScale := oldSubresources.Scale, newSubresources.Scale
+
+	switch {
+	case oldScale == nil:
+		// adding the scale subresource is compatible
+	case newScale == nil:
+		errs = append(errs, fmt.Errorf("%w : %v : horizontal pod autoscalers and kubectl scale can no longer scale the resource", ErrScaleSubresourceRemoved, version))
+	default:
+		paths := []struct {
+			name     string
+			old, new string
+		}{
+			{name: "specReplicasPath", old: oldScale.SpecReplicasPath, new: newScale.SpecReplicasPath},
+			{name: "statusReplicasPath", old: oldScale.StatusReplicasPath, new: newScale.StatusReplicasPath},
+			{name: "labelSelectorPath", old: ptr.Deref(oldScale.LabelSelectorPath, ""), new: ptr.Deref(newScale.LabelSelectorPath, "")},
+		}
+
+		for _, path := range paths {
+			if path.old != path.new {
+				errs = append(errs, fmt.Errorf("%w : %v : %s %q -> %q : horizontal pod autoscalers and kubectl scale read and write a different field",
+					ErrScalePathChanged, version, path.name, path.old, path.new))
+			}
+		}
+	}
+
+	return errs
+}
+
+// checkScalePaths checks that the scale paths of a version resolve to properties
+// of the expected type in the schema of that version.
+func checkScalePaths(version *apiextensionsv1.CustomResourceDefinitionVersion) []error {
+	if version.Subresources == nil || version.Subresources.Scale == nil || version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+		return nil
+	}
+
+	scale := version.Subresources.Scale
+	paths := []struct {
+		name, path, wantType string
+	}{
+		{name: "specReplicasPath", path: scale.SpecReplicasPath, wantType: "integer"},
+		{name: "statusReplicasPath", path: scale.StatusReplicasPath, wantType: "integer"},
+		{name: "labelSelectorPath", path: ptr.Deref(scale.LabelSelectorPath, ""), wantType: "string"},
+	}
+
+	errs := []error{}
+
+	for _, path := range paths {
+		if path.path == "" {
+			continue
+		}
+
+		property, ok := resolvePath(version.Schema.OpenAPIV3Schema, path.path)
+
+		switch {
+		case !ok:
+			// the type of the data at the path can't be determined from the schema
+		case property == nil:
+			errs = append(errs, fmt.Errorf("%w : %v : %s %q does not resolve to a property", ErrScalePathInvalid, version.Name, path.name, path.path))
+		case property.Type != path.wantType:
+			errs = append(errs, fmt.Errorf("%w : %v : %s %q resolves to a property of type %q, expected %q", ErrScalePathInvalid, version.Name, path.name, path.path, property.Type, path.wantType))
+		}
+	}
+
+	return errs
+}
+
+// resolvePath returns the property of the schema that the provided
+// JSON path (i.e .spec.replicas) refers to, or nil if there is none.
+// Resolving stops at properties that preserve unknown fields, set additionalProperties
+// or are int-or-string, as the data at the path can't be determined from the schema
+// below them. In that case false is returned.
+func resolvePath(schema *apiextensionsv1.JSONSchemaProps, path string) (*apiextensionsv1.JSONSchemaProps, bool) {
+	if !strings.HasPrefix(path, ".") {
+		return nil, true
+	}
+
+	current := schema
+
+	for _, field := range strings.Split(strings.TrimPrefix(path, "."), ".") {
+		if !resolvable(current) {
+			return nil, false
+		}
+
+		property, ok := current.Properties[field]
+		if !ok {
+			return nil, true
+		}
+
+		current = &property
+	}
+
+	return current, resolvable(current)
+}
+
+// resolvable returns whether the data described by the schema is fully
+// determined by its type and properties.
+func resolvable(schema *apiextensionsv1.JSONSchemaProps) bool {
+	return !ptr.Deref(schema.XPreserveUnknownFields, false) && schema.AdditionalProperties == nil && !schema.XIntOrString
+}
+
+// ErrStatusSubresourceAdded represents an error state where the status subresource was added to a version.
+var ErrStatusSubresourceAdded = errors.New("status subresource added")
+
+// ErrStatusSubresourceRemoved represents an error state where the status subresource was removed from a version.
+var ErrStatusSubresourceRemoved = errors.New("status subresource removed")
+
+// ErrScaleSubresourceRemoved represents an error state where the scale subresource was removed from a version.
+var ErrScaleSubresourceRemoved = errors.New("scale subresource removed")
+
+// ErrScalePathChanged represents an error state where a path of the scale subresource of a version changed.
+var ErrScalePathChanged = errors.New("scale path changed")
+
+// ErrScalePathInvalid represents an error state where a path of the scale subresource of a version
+// does not resolve to a property of the expected type.
+var ErrScalePathInvalid = errors.New("scale path invalid")
@@ -0,0 +1,127 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versionlifecycle
+
+import (
+	"errors"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+)
+
+var (
+	_ validations.Validation                                           = (*VersionLifecycle)(nil)
+	_ validations.Comparator[apiextensionsv1.CustomResourceDefinition] = (*VersionLifecycle)(nil)
+)
+
+const name = "versionLifecycle"
+
+// Register registers the VersionLifecycle validation
+// with the provided validation registry.
+func Register(registry validations.Registry) {
+	registry.Register(name, factory)
+}
+
+// factory is a function used to initialize a VersionLifecycle validation
+// implementation based on the provided configuration.
+func factory(_ map[string]interface{}) (validations.Validation, error) {
+	return &VersionLifecycle{}, nil
+}
+
+// VersionLifecycle is a validations.Validation implementation
+// used to check if the storage version has changed, or if any
+// served versions are no longer served, from one CRD instance to another.
+type VersionLifecycle struct {
+	// enforcement is the EnforcementPolicy that this validation
+	// should use when performing its validation logic
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the VersionLifecycle validation.
+func (vl *VersionLifecycle) Name() string {
+	return name
+}
+
+// SetEnforcement sets the EnforcementPolicy for the VersionLifecycle validation.
+func (vl *VersionLifecycle) SetEnforcement(enforcement config.EnforcementPolicy) {
+	vl.enforcement = enforcement
+}
+
+// Compare compares an old and a new CustomResourceDefinition, checking for a change of the storage version
+// and for versions that were served in the old CustomResourceDefinition but are no longer served in the new one.
+// A version that is no longer served is reported as an error when objects may still be stored in it, meaning
+// it is in the stored versions of the old CustomResourceDefinition or is its storage version, and as a warning otherwise.
+func (vl *VersionLifecycle) Compare(a, b *apiextensionsv1.CustomResourceDefinition) validations.ComparisonResult {
+	errs := []error{}
+	warns := []error{}
+
+	oldStorageVersion := storageVersion(a)
+	newStorageVersion := storageVersion(b)
+
+	if oldStorageVersion != "" && newStorageVersion != "" && oldStorageVersion != newStorageVersion {
+		errs = append(errs, fmt.Errorf("%w : %q -> %q : new and updated objects are stored as %q, existing objects remain stored as %q until they are migrated",
+			ErrStorageVersionChanged, oldStorageVersion, newStorageVersion, newStorageVersion, oldStorageVersion))
+	}
+
+	storedVersions := sets.New(a.Status.StoredVersions...)
+	if oldStorageVersion != "" {
+		storedVersions.Insert(oldStorageVersion)
+	}
+
+	for _, oldVersion := range a.Spec.Versions {
+		newVersion := validations.GetCRDVersionByName(b, oldVersion.Name)
+		if !oldVersion.Served || newVersion == nil || newVersion.Served {
+			continue
+		}
+
+		if storedVersions.Has(oldVersion.Name) {
+			errs = append(errs, fmt.Errorf("%w : %q : clients using %q can no longer access the API and objects stored as %q can only be read through other versions",
+				ErrStoredVersionUnserved, oldVersion.Name, oldVersion.Name, oldVersion.Name))
+
+			continue
+		}
+
+		warns = append(warns, fmt.Errorf("%w : %q : clients using %q can no longer access the API", ErrVersionUnserved, oldVersion.Name, oldVersion.Name))
+	}
+
+	return validations.HandleErrorsAndWarnings(vl.Name(), vl.enforcement, errs, warns)
+}
+
+// storageVersion returns the name of the storage version of
+// the provided CustomResourceDefinition, if it has one.
+func storageVersion(crd *apiextensionsv1.CustomResourceDefinition) string {
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			return version.Name
+		}
+	}
+
+	return ""
+}
+
+// ErrStorageVersionChanged represents an error state where the storage version
+// of the CustomResourceDefinition has changed.
+var ErrStorageVersionChanged = errors.New("storage version changed")
+
+// ErrStoredVersionUnserved represents an error state where a version that objects
+// may be stored in is no longer served.
+var ErrStoredVersionUnserved = errors.New("stored version no longer served")
+
+// ErrVersionUnserved represents a state where a version that no objects
+// are stored in is no longer served.
+var ErrVersionUnserved = errors.New("version no longer served")
@@ -0,0 +1,321 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versionlifecycle
+
+import (
+	"slices"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/crdify/pkg/config"
+	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
+)
+
+func TestVersionLifecycle(t *testing.T) {
+	testcases := []internaltesting.Testcase[apiextensionsv1.CustomResourceDefinition]{
+		{
+			Name: "no changes, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:    "v1alpha1",
+							Served:  true,
+							Storage: false,
+						},
+						{
+							Name:    "v1",
+							Served:  true,
+							Storage: true,
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:    "v1alpha1",
+							Served:  true,
+							Storage: false,
+						},
+						{
+							Name:    "v1",
+							Served:  true,
+							Storage: true,
+						},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &VersionLifecycle{},
+		},
+		{
+			Name: "version added and served, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:    "v1",
+							Served:  true,
+							Storage: true,
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:    "v1",
+							Served:  true,
+							Storage: true,
+						},
+						{
+							Name:    "v2",
+							Served:  true,
+							Storage: false,
+						},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &VersionLifecycle{},
+		},
+		{
+			Name: "storage version changed, flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:    "v1",
+							Served:  true,
+							Storage: true,
+						},
+						{
+							Name:    "v2",
+							Served:  true,
+							Storage: false,
+						},
+					},
+				},
+				Status: apiextensionsv1.CustomResourceDefinitionStatus{
+					StoredVersions: []string{"v1"},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:    "v1",
+							Served:  true,
+							Storage: false,
+						},
+						{
+							Name:    "v2",
+							Served:  true,
+							Storage: true,
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &VersionLifecycle{},
+		},
+		{
+			Name: "stored version no longer served, flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:    "v1alpha1",
+							Served:  true,
+							Storage: false,
+						},
+						{
+							Name:    "v1",
+							Served:  true,
+							Storage: true,
+						},
+					},
+				},
+				Status: apiextensionsv1.CustomResourceDefinitionStatus{
+					StoredVersions: []string{"v1alpha1", "v1"},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:    "v1alpha1",
+							Served:  false,
+							Storage: false,
+						},
+						{
+							Name:    "v1",
+							Served:  true,
+							Storage: true,
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &VersionLifecycle{},
+		},
+		{
+			Name: "version that was not served remains not served, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:    "v1alpha1",
+							Served:  false,
+							Storage: false,
+						},
+						{
+							Name:    "v1",
+							Served:  true,
+							Storage: true,
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:    "v1alpha1",
+							Served:  false,
+							Storage: false,
+						},
+						{
+							Name:    "v1",
+							Served:  true,
+							Storage: true,
+						},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &VersionLifecycle{},
+		},
+	}
+
+	internaltesting.RunTestcases(t, testcases...)
+}
+
+func TestVersionLifecycleStorageVersionNoLongerServed(t *testing.T) {
+	val := &VersionLifecycle{}
+	val.SetEnforcement(config.EnforcementPolicyError)
+
+	result := val.Compare(
+		&apiextensionsv1.CustomResourceDefinition{
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{
+						Name:    "v1",
+						Served:  true,
+						Storage: true,
+					},
+					{
+						Name:    "v2",
+						Served:  true,
+						Storage: false,
+					},
+				},
+			},
+		},
+		&apiextensionsv1.CustomResourceDefinition{
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{
+						Name:    "v1",
+						Served:  false,
+						Storage: false,
+					},
+					{
+						Name:    "v2",
+						Served:  true,
+						Storage: true,
+					},
+				},
+			},
+		},
+	)
+
+	expected := []string{
+		`storage version changed : "v1" -> "v2" : new and updated objects are stored as "v2", existing objects remain stored as "v1" until they are migrated`,
+		`stored version no longer served : "v1" : clients using "v1" can no longer access the API and objects stored as "v1" can only be read through other versions`,
+	}
+
+	if !slices.Equal(result.Errors, expected) {
+		t.Fatalf("expected errors %q, got %q", expected, result.Errors)
+	}
+}
+
+func TestVersionLifecycleVersionNoLongerServedWarned(t *testing.T) {
+	val := &VersionLifecycle{}
+	val.SetEnforcement(config.EnforcementPolicyError)
+
+	result := val.Compare(
+		&apiextensionsv1.CustomResourceDefinition{
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{
+						Name:    "v1alpha1",
+						Served:  true,
+						Storage: false,
+					},
+					{
+						Name:    "v1",
+						Served:  true,
+						Storage: true,
+					},
+				},
+			},
+			Status: apiextensionsv1.CustomResourceDefinitionStatus{
+				StoredVersions: []string{"v1"},
+			},
+		},
+		&apiextensionsv1.CustomResourceDefinition{
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{
+						Name:    "v1alpha1",
+						Served:  false,
+						Storage: false,
+					},
+					{
+						Name:    "v1",
+						Served:  true,
+						Storage: true,
+					},
+				},
+			},
+		},
+	)
+
+	if len(result.Errors) > 0 {
+		t.Fatalf("expected no errors, got %q", result.Errors)
+	}
+
+	expected := []string{
+		`version no longer served : "v1alpha1" : clients using "v1alpha1" can no longer access the API`,
+	}
+
+	if !slices.Equal(result.Warnings, expected) {
+		t.Fatalf("expected warnings %q, got %q", expected, result.Warnings)
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versionremoval
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+	"sigs.k8s.io/crdify/pkg/validations/property"
+)
+
+var (
+	_ validations.Validation                                           = (*VersionRemoval)(nil)
+	_ validations.Comparator[apiextensionsv1.CustomResourceDefinition] = (*VersionRemoval)(nil)
+)
+
+const (
+	name = "versionRemoval"
+
+	// defaultMinimumDeprecatedReleases is the MinimumDeprecatedReleases
+	// used when none is configured.
+	defaultMinimumDeprecatedReleases = 1
+)
+
+// Register registers the VersionRemoval validation
+// with the provided validation registry.
+func Register(registry validations.Registry) {
+	registry.Register(name, factory)
+}
+
+// factory is a function used to initialize a VersionRemoval validation
+// implementation based on the provided configuration.
+func factory(cfg map[string]interface{}) (validations.Validation, error) {
+	versionRemovalCfg := &VersionRemovalConfig{}
+
+	err := property.ConfigToType(cfg, versionRemovalCfg)
+	if err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	err = ValidateVersionRemovalConfig(versionRemovalCfg)
+	if err != nil {
+		return nil, fmt.Errorf("validating versionRemoval config: %w", err)
+	}
+
+	return &VersionRemoval{VersionRemovalConfig: *versionRemovalCfg}, nil
+}
+
+// ValidateVersionRemovalConfig ensures provided VersionRemovalConfig is valid and defaults missing values.
+func ValidateVersionRemovalConfig(in *VersionRemovalConfig) error {
+	if in == nil {
+		return nil
+	}
+
+	switch {
+	case in.MinimumDeprecatedReleases < 0:
+		return fmt.Errorf("%w : %d (must not be negative)", errInvalidMinimumDeprecatedReleases, in.MinimumDeprecatedReleases)
+	case in.MinimumDeprecatedReleases == 0:
+		in.MinimumDeprecatedReleases = defaultMinimumDeprecatedReleases
+	}
+
+	for i, release := range in.ReleaseHistory {
+		if release.Release == "" {
+			return fmt.Errorf("%w : releaseHistory[%d] (release must not be empty)", errInvalidReleaseHistory, i)
+		}
+	}
+
+	return nil
+}
+
+var (
+	errInvalidMinimumDeprecatedReleases = errors.New("invalid minimumDeprecatedReleases")
+	errInvalidReleaseHistory            = errors.New("invalid releaseHistory")
+)
+
+// VersionRemovalConfig contains the configuration options for the VersionRemoval validation.
+type VersionRemovalConfig struct {
+	// MinimumDeprecatedReleases is the minimum number of consecutive releases a served
+	// version must have been deprecated in before it is removed. The old CustomResourceDefinition
+	// counts as the most recent release, earlier releases are described by the ReleaseHistory.
+	// Defaults to 1, meaning the version must be deprecated in the old CustomResourceDefinition.
+	MinimumDeprecatedReleases int `json:"minimumDeprecatedReleases,omitempty"`
+
+	// ReleaseHistory describes the releases preceding the old CustomResourceDefinition,
+	// ordered from the oldest to the most recent release.
+	ReleaseHistory []Release `json:"releaseHistory,omitempty"`
+}
+
+// Release describes the versions of a CustomResourceDefinition that were deprecated in a release.
+type Release struct {
+	// Release is the name of the release (i.e v1.2.0).
+	Release string `json:"release"`
+
+	// DeprecatedVersions are the names of the versions that were deprecated in the release.
+	DeprecatedVersions []string `json:"deprecatedVersions,omitempty"`
+}
+
+// VersionRemoval is a validations.Validation implementation
+// used to check if any served versions have been removed from
+// one CRD instance to another without being deprecated first.
+type VersionRemoval struct {
+	VersionRemovalConfig
+
+	// enforcement is the EnforcementPolicy that this validation
+	// should use when performing its validation logic
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the VersionRemoval validation.
+func (vr *VersionRemoval) Name() string {
+	return name
+}
+
+// SetEnforcement sets the EnforcementPolicy for the VersionRemoval validation.
+func (vr *VersionRemoval) SetEnforcement(enforcement config.EnforcementPolicy) {
+	vr.enforcement = enforcement
+}
+
+// Compare compares an old and a new CustomResourceDefinition, checking for served versions of the old
+// CustomResourceDefinition that were removed in the new CustomResourceDefinition without being deprecated,
+// with a deprecation warning, in the old CustomResourceDefinition or without being deprecated for the
+// configured minimum number of releases.
+func (vr *VersionRemoval) Compare(a, b *apiextensionsv1.CustomResourceDefinition) validations.ComparisonResult {
+	errs := []error{}
+
+	for _, oldVersion := range a.Spec.Versions {
+		if !oldVersion.Served || validations.GetCRDVersionByName(b, oldVersion.Name) != nil {
+			continue
+		}
+
+		if !oldVersion.Deprecated {
+			errs = append(errs, fmt.Errorf("%w : %q : the version was not deprecated before it was removed", ErrVersionRemovedWithoutDeprecation, oldVersion.Name))
+			continue
+		}
+
+		if ptr.Deref(oldVersion.DeprecationWarning, "") == "" {
+			errs = append(errs, fmt.Errorf("%w : %q : the version was deprecated without a deprecation warning before it was removed", ErrVersionRemovedWithoutDeprecation, oldVersion.Name))
+			continue
+		}
+
+		if deprecatedReleases := vr.deprecatedReleases(oldVersion.Name); deprecatedReleases < vr.MinimumDeprecatedReleases {
+			errs = append(errs, fmt.Errorf("%w : %q : the version was deprecated for %d releases before it was removed, at least %d are required",
+				ErrVersionRemovedTooEarly, oldVersion.Name, deprecatedReleases, vr.MinimumDeprecatedReleases))
+		}
+	}
+
+	return validations.HandleErrors(vr.Name(), vr.enforcement, errs...)
+}
+
+// deprecatedReleases returns the number of consecutive releases, up to and including
+// the old CustomResourceDefinition, that the version was deprecated in.
+// The version is expected to be deprecated in the old CustomResourceDefinition.
+func (vr *VersionRemoval) deprecatedReleases(version string) int {
+	releases := 1
+
+	for _, release := range slices.Backward(vr.ReleaseHistory) {
+		if !slices.Contains(release.DeprecatedVersions, version) {
+			break
+		}
+
+		releases++
+	}
+
+	return releases
+}
+
+// ErrVersionRemovedWithoutDeprecation represents an error state where a served version
+// was removed without being deprecated first.
+var ErrVersionRemovedWithoutDeprecation = errors.New("version removed without deprecation")
+
+// ErrVersionRemovedTooEarly represents an error state where a served version was removed
+// before being deprecated for the minimum number of releases.
+var ErrVersionRemovedTooEarly = errors.New("version removed too early")
@@ -0,0 +1,363 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versionremoval
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/crdify/pkg/config"
+	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
+)
+
+func TestVersionRemoval(t *testing.T) {
+	testcases := []internaltesting.Testcase[apiextensionsv1.CustomResourceDefinition]{
+		{
+			Name: "no version removed, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+						},
+						{
+							Name:   "v1",
+							Served: true,
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+						},
+						{
+							Name:   "v1",
+							Served: true,
+						},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &VersionRemoval{},
+		},
+		{
+			Name: "served version removed without deprecation, flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1alpha1",
+							Served: true,
+						},
+						{
+							Name:   "v1",
+							Served: true,
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1",
+							Served: true,
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &VersionRemoval{},
+		},
+		{
+			Name: "served version removed after deprecation without deprecation warning, flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:       "v1alpha1",
+							Served:     true,
+							Deprecated: true,
+						},
+						{
+							Name:   "v1",
+							Served: true,
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1",
+							Served: true,
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &VersionRemoval{},
+		},
+		{
+			Name: "served version removed after deprecation, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:               "v1alpha1",
+							Served:             true,
+							Deprecated:         true,
+							DeprecationWarning: ptr.To("v1alpha1 is deprecated"),
+						},
+						{
+							Name:   "v1",
+							Served: true,
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1",
+							Served: true,
+						},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &VersionRemoval{},
+		},
+		{
+			Name: "version that was not served removed, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name: "v1alpha1",
+						},
+						{
+							Name:   "v1",
+							Served: true,
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1",
+							Served: true,
+						},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &VersionRemoval{},
+		},
+		{
+			Name: "served version removed after deprecation in fewer than the minimum releases, flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:               "v1alpha1",
+							Served:             true,
+							Deprecated:         true,
+							DeprecationWarning: ptr.To("v1alpha1 is deprecated"),
+						},
+						{
+							Name:   "v1",
+							Served: true,
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1",
+							Served: true,
+						},
+					},
+				},
+			},
+			Flagged: true,
+			ComparableValidation: &VersionRemoval{VersionRemovalConfig: VersionRemovalConfig{
+				MinimumDeprecatedReleases: 3,
+				ReleaseHistory: []Release{
+					{Release: "v0.1.0"},
+					{Release: "v0.2.0", DeprecatedVersions: []string{"v1alpha1"}},
+				},
+			}},
+		},
+		{
+			Name: "served version removed after deprecation in the minimum releases, not flagged",
+			Old: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:               "v1alpha1",
+							Served:             true,
+							Deprecated:         true,
+							DeprecationWarning: ptr.To("v1alpha1 is deprecated"),
+						},
+						{
+							Name:   "v1",
+							Served: true,
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.CustomResourceDefinition{
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{
+							Name:   "v1",
+							Served: true,
+						},
+					},
+				},
+			},
+			Flagged: false,
+			ComparableValidation: &VersionRemoval{VersionRemovalConfig: VersionRemovalConfig{
+				MinimumDeprecatedReleases: 3,
+				ReleaseHistory: []Release{
+					{Release: "v0.1.0", DeprecatedVersions: []string{"v1alpha1"}},
+					{Release: "v0.2.0", DeprecatedVersions: []string{"v1alpha1"}},
+				},
+			}},
+		},
+	}
+
+	internaltesting.RunTestcases(t, testcases...)
+}
+
+func TestVersionRemovalDeprecatedReleases(t *testing.T) {
+	val := &VersionRemoval{VersionRemovalConfig: VersionRemovalConfig{
+		MinimumDeprecatedReleases: 3,
+		ReleaseHistory: []Release{
+			{Release: "v0.1.0", DeprecatedVersions: []string{"v1alpha1"}},
+			{Release: "v0.2.0"},
+			{Release: "v0.3.0", DeprecatedVersions: []string{"v1alpha1"}},
+		},
+	}}
+	val.SetEnforcement(config.EnforcementPolicyError)
+
+	result := val.Compare(
+		&apiextensionsv1.CustomResourceDefinition{
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{
+						Name:               "v1alpha1",
+						Served:             true,
+						Deprecated:         true,
+						DeprecationWarning: ptr.To("v1alpha1 is deprecated"),
+					},
+					{
+						Name:   "v1",
+						Served: true,
+					},
+				},
+			},
+		},
+		&apiextensionsv1.CustomResourceDefinition{
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{
+						Name:   "v1",
+						Served: true,
+					},
+				},
+			},
+		},
+	)
+
+	expected := []string{
+		`version removed too early : "v1alpha1" : the version was deprecated for 2 releases before it was removed, at least 3 are required`,
+	}
+
+	if !slices.Equal(result.Errors, expected) {
+		t.Fatalf("expected errors %q, got %q", expected, result.Errors)
+	}
+}
+
+func TestValidateVersionRemovalConfig(t *testing.T) {
+	testcases := []struct {
+		name                          string
+		cfg                           *VersionRemovalConfig
+		wantErr                       error
+		wantMinimumDeprecatedReleases int
+	}{
+		{
+			name: "nil config",
+			cfg:  nil,
+		},
+		{
+			name:                          "defaults minimum deprecated releases",
+			cfg:                           &VersionRemovalConfig{},
+			wantMinimumDeprecatedReleases: defaultMinimumDeprecatedReleases,
+		},
+		{
+			name:                          "keeps configured minimum deprecated releases",
+			cfg:                           &VersionRemovalConfig{MinimumDeprecatedReleases: 2, ReleaseHistory: []Release{{Release: "v0.1.0"}}},
+			wantMinimumDeprecatedReleases: 2,
+		},
+		{
+			name:    "negative minimum deprecated releases",
+			cfg:     &VersionRemovalConfig{MinimumDeprecatedReleases: -1},
+			wantErr: errInvalidMinimumDeprecatedReleases,
+		},
+		{
+			name:    "release without a name",
+			cfg:     &VersionRemovalConfig{ReleaseHistory: []Release{{DeprecatedVersions: []string{"v1alpha1"}}}},
+			wantErr: errInvalidReleaseHistory,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateVersionRemovalConfig(tc.cfg)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tc.cfg != nil && tc.cfg.MinimumDeprecatedReleases != tc.wantMinimumDeprecatedReleases {
+				t.Fatalf("expected minimum deprecated releases %d, got %d", tc.wantMinimumDeprecatedReleases, tc.cfg.MinimumDeprecatedReleases)
+			}
+		})
+	}
+}
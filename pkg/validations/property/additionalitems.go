@@ -0,0 +1,83 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+)
+
+var (
+	_ validations.Validation                                  = (*AdditionalItems)(nil)
+	_ validations.Comparator[apiextensionsv1.JSONSchemaProps] = (*AdditionalItems)(nil)
+)
+
+const additionalItemsValidationName = "additionalItems"
+
+// RegisterAdditionalItems registers the AdditionalItems validation
+// with the provided validation registry.
+func RegisterAdditionalItems(registry validations.Registry) {
+	registry.Register(additionalItemsValidationName, additionalItemsFactory)
+}
+
+// additionalItemsFactory is a function used to initialize an AdditionalItems validation
+// implementation based on the provided configuration.
+func additionalItemsFactory(_ map[string]interface{}) (validations.Validation, error) {
+	return &AdditionalItems{}, nil
+}
+
+// AdditionalItems is a Validation that can be used to identify
+// incompatible transitions of the additionalItems of CRD properties
+// between `true`, `false` and a schema.
+type AdditionalItems struct {
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the AdditionalItems validation.
+func (ai *AdditionalItems) Name() string {
+	return additionalItemsValidationName
+}
+
+// SetEnforcement sets the EnforcementPolicy for the AdditionalItems validation.
+func (ai *AdditionalItems) SetEnforcement(policy config.EnforcementPolicy) {
+	ai.enforcement = policy
+}
+
+// Compare compares an old and a new JSONSchemaProps, checking for transitions of the additionalItems
+// of a property that result in existing list items being rejected.
+// Changes to the additionalItems schema itself are evaluated as a property of its own
+// (i.e ^.spec.foo.additionalItems) and are not evaluated by this method.
+// In order for callers to determine if diffs to a JSONSchemaProps have been handled by this validation
+// the JSONSchemaProps.AdditionalItems field will be reset to 'nil' as part of this method.
+// It is highly recommended that only copies of the JSONSchemaProps to compare are provided to this method
+// to prevent unintentional modifications.
+func (ai *AdditionalItems) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.ComparisonResult {
+	err := compareSchemaOrBool(a.AdditionalItems, b.AdditionalItems, ErrAdditionalItemsDisallowed, ErrAdditionalItemsConstrained, "additional list items")
+
+	a.AdditionalItems = nil
+	b.AdditionalItems = nil
+
+	return validations.HandleErrors(ai.Name(), ai.enforcement, err)
+}
+
+// ErrAdditionalItemsDisallowed represents an error state when additional items are no longer allowed.
+var ErrAdditionalItemsDisallowed = errors.New("additional items disallowed")
+
+// ErrAdditionalItemsConstrained represents an error state when additional items
+// that were previously allowed without restriction now have to match a schema.
+var ErrAdditionalItemsConstrained = errors.New("additional items constrained by schema")
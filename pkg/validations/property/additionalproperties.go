@@ -0,0 +1,125 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+)
+
+var (
+	_ validations.Validation                                  = (*AdditionalProperties)(nil)
+	_ validations.Comparator[apiextensionsv1.JSONSchemaProps] = (*AdditionalProperties)(nil)
+)
+
+const additionalPropertiesValidationName = "additionalProperties"
+
+const (
+	schemaOrBoolUnset  = "unset"
+	schemaOrBoolTrue   = "true"
+	schemaOrBoolFalse  = "false"
+	schemaOrBoolSchema = "schema"
+)
+
+// RegisterAdditionalProperties registers the AdditionalProperties validation
+// with the provided validation registry.
+func RegisterAdditionalProperties(registry validations.Registry) {
+	registry.Register(additionalPropertiesValidationName, additionalPropertiesFactory)
+}
+
+// additionalPropertiesFactory is a function used to initialize an AdditionalProperties validation
+// implementation based on the provided configuration.
+func additionalPropertiesFactory(_ map[string]interface{}) (validations.Validation, error) {
+	return &AdditionalProperties{}, nil
+}
+
+// AdditionalProperties is a Validation that can be used to identify
+// incompatible transitions of the additionalProperties of CRD properties
+// between `true`, `false` and a schema.
+type AdditionalProperties struct {
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the AdditionalProperties validation.
+func (ap *AdditionalProperties) Name() string {
+	return additionalPropertiesValidationName
+}
+
+// SetEnforcement sets the EnforcementPolicy for the AdditionalProperties validation.
+func (ap *AdditionalProperties) SetEnforcement(policy config.EnforcementPolicy) {
+	ap.enforcement = policy
+}
+
+// Compare compares an old and a new JSONSchemaProps, checking for transitions of the additionalProperties
+// of a property that result in existing map entries being rejected.
+// Changes to the additionalProperties schema itself are evaluated as a property of its own
+// (i.e ^.spec.foo.additionalProperties) and are not evaluated by this method.
+// In order for callers to determine if diffs to a JSONSchemaProps have been handled by this validation
+// the JSONSchemaProps.AdditionalProperties field will be reset to 'nil' as part of this method.
+// It is highly recommended that only copies of the JSONSchemaProps to compare are provided to this method
+// to prevent unintentional modifications.
+func (ap *AdditionalProperties) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.ComparisonResult {
+	err := compareSchemaOrBool(a.AdditionalProperties, b.AdditionalProperties, ErrAdditionalPropertiesDisallowed, ErrAdditionalPropertiesConstrained, "map entries")
+
+	a.AdditionalProperties = nil
+	b.AdditionalProperties = nil
+
+	return validations.HandleErrors(ap.Name(), ap.enforcement, err)
+}
+
+// compareSchemaOrBool compares an old and a new JSONSchemaPropsOrBool, returning
+// disallowedErr when entries that were previously accepted are no longer accepted at all and
+// constrainedErr when entries that were previously accepted without restriction now have to
+// match a schema. An unset value accepts any entry, the same as `true`.
+// entries describes what is affected by the transition and is used in the error message.
+func compareSchemaOrBool(a, b *apiextensionsv1.JSONSchemaPropsOrBool, disallowedErr, constrainedErr error, entries string) error {
+	oldState := schemaOrBoolStateOf(a)
+	newState := schemaOrBoolStateOf(b)
+
+	switch {
+	case newState == schemaOrBoolFalse && oldState != schemaOrBoolFalse:
+		return fmt.Errorf("%w : %s -> %s : all existing %s are rejected", disallowedErr, oldState, newState, entries)
+	case newState == schemaOrBoolSchema && (oldState == schemaOrBoolUnset || oldState == schemaOrBoolTrue):
+		return fmt.Errorf("%w : %s -> %s : existing %s that do not match the schema are rejected", constrainedErr, oldState, newState, entries)
+	default:
+		return nil
+	}
+}
+
+// schemaOrBoolStateOf returns whether the provided JSONSchemaPropsOrBool is
+// unset, `true`, `false` or a schema.
+func schemaOrBoolStateOf(s *apiextensionsv1.JSONSchemaPropsOrBool) string {
+	switch {
+	case s == nil:
+		return schemaOrBoolUnset
+	case s.Schema != nil:
+		return schemaOrBoolSchema
+	case s.Allows:
+		return schemaOrBoolTrue
+	default:
+		return schemaOrBoolFalse
+	}
+}
+
+// ErrAdditionalPropertiesDisallowed represents an error state when additional properties are no longer allowed.
+var ErrAdditionalPropertiesDisallowed = errors.New("additional properties disallowed")
+
+// ErrAdditionalPropertiesConstrained represents an error state when additional properties
+// that were previously allowed without restriction now have to match a schema.
+var ErrAdditionalPropertiesConstrained = errors.New("additional properties constrained by schema")
@@ -0,0 +1,203 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"strings"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/crdify/pkg/config"
+	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
+)
+
+func TestAdditionalProperties(t *testing.T) {
+	testcases := []internaltesting.Testcase[apiextensionsv1.JSONSchemaProps]{
+		{
+			Name: "no diff, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: true},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: true},
+			},
+			Flagged:              false,
+			ComparableValidation: &AdditionalProperties{},
+		},
+		{
+			Name: "unset to false, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: nil,
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: false},
+			},
+			Flagged:              true,
+			ComparableValidation: &AdditionalProperties{},
+		},
+		{
+			Name: "true to false, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: true},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: false},
+			},
+			Flagged:              true,
+			ComparableValidation: &AdditionalProperties{},
+		},
+		{
+			Name: "schema to false, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: true, Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: false},
+			},
+			Flagged:              true,
+			ComparableValidation: &AdditionalProperties{},
+		},
+		{
+			Name: "unset to schema, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: nil,
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: true, Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"}},
+			},
+			Flagged:              true,
+			ComparableValidation: &AdditionalProperties{},
+		},
+		{
+			Name: "true to schema, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: true},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: true, Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"}},
+			},
+			Flagged:              true,
+			ComparableValidation: &AdditionalProperties{},
+		},
+		{
+			Name: "false to true, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: false},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: true},
+			},
+			Flagged:              false,
+			ComparableValidation: &AdditionalProperties{},
+		},
+		{
+			Name: "false to schema, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: false},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: true, Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"}},
+			},
+			Flagged:              false,
+			ComparableValidation: &AdditionalProperties{},
+		},
+		{
+			Name: "schema to true, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: true, Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: true},
+			},
+			Flagged:              false,
+			ComparableValidation: &AdditionalProperties{},
+		},
+		{
+			Name: "schema to unset, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: true, Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: nil,
+			},
+			Flagged:              false,
+			ComparableValidation: &AdditionalProperties{},
+		},
+		{
+			Name: "schema changed, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: true, Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:                 "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: true, Schema: &apiextensionsv1.JSONSchemaProps{Type: "integer"}},
+			},
+			Flagged:              false,
+			ComparableValidation: &AdditionalProperties{},
+		},
+		{
+			Name: "different field changed, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				ID: "foo",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				ID: "bar",
+			},
+			Flagged:              false,
+			ComparableValidation: &AdditionalProperties{},
+		},
+	}
+
+	internaltesting.RunTestcases(t, testcases...)
+}
+
+func TestAdditionalPropertiesExplainsRejectedEntries(t *testing.T) {
+	val := &AdditionalProperties{}
+	val.SetEnforcement(config.EnforcementPolicyError)
+
+	result := val.Compare(
+		&apiextensionsv1.JSONSchemaProps{Type: "object", AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: true}},
+		&apiextensionsv1.JSONSchemaProps{Type: "object", AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: false}},
+	)
+
+	if len(result.Errors) != 1 {
+		t.Fatalf("expected a single error, got %v", result.Errors)
+	}
+
+	if !strings.Contains(result.Errors[0], "true -> false : all existing map entries are rejected") {
+		t.Fatalf("expected error to explain the rejected entries, got %q", result.Errors[0])
+	}
+}
@@ -0,0 +1,299 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+)
+
+var (
+	_ validations.Validation                                  = (*Composition)(nil)
+	_ validations.Comparator[apiextensionsv1.JSONSchemaProps] = (*Composition)(nil)
+)
+
+const compositionValidationName = "composition"
+
+// RegisterComposition registers the Composition validation
+// with the provided validation registry.
+func RegisterComposition(registry validations.Registry) {
+	registry.Register(compositionValidationName, compositionFactory)
+}
+
+// compositionFactory is a function used to initialize a Composition validation
+// implementation based on the provided configuration.
+func compositionFactory(_ map[string]interface{}) (validations.Validation, error) {
+	return &Composition{}, nil
+}
+
+// Composition is a Validation that can be used to identify
+// incompatible changes to the allOf, anyOf, oneOf and not
+// schema compositions of CRD properties.
+type Composition struct {
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the Composition validation.
+func (c *Composition) Name() string {
+	return compositionValidationName
+}
+
+// SetEnforcement sets the EnforcementPolicy for the Composition validation.
+func (c *Composition) SetEnforcement(policy config.EnforcementPolicy) {
+	c.enforcement = policy
+}
+
+// Compare compares an old and a new JSONSchemaProps, checking for changes to the allOf, anyOf, oneOf and not
+// schema compositions of a property that narrow the values accepted by the property.
+// Branches are matched by their content rather than their index, so reordering branches is not flagged.
+// The documentation fields of branches (description, title, example and externalDocs) are ignored when
+// matching branches, as they don't affect the accepted values.
+// A branch that changed is treated as the old branch being removed and the new branch being added.
+// In order for callers to determine if diffs to a JSONSchemaProps have been handled by this validation
+// the JSONSchemaProps.AllOf, JSONSchemaProps.AnyOf, JSONSchemaProps.OneOf and JSONSchemaProps.Not fields
+// will be reset to 'nil' as part of this method.
+// It is highly recommended that only copies of the JSONSchemaProps to compare are provided to this method
+// to prevent unintentional modifications.
+func (c *Composition) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.ComparisonResult {
+	errs := []error{}
+
+	// every allOf branch has to match, so new branches narrow the accepted values
+	for _, i := range unmatchedBranches(b.AllOf, a.AllOf) {
+		errs = append(errs, fmt.Errorf("%w : allOf[%d] %s", ErrAllOfConstraintAdded, i, branchSummary(&b.AllOf[i])))
+	}
+
+	// at least one anyOf branch has to match, so removed branches narrow the accepted values
+	// unless all of them were removed. Adding anyOf to a property that had none narrows the accepted
+	// values to the ones matching one of the new branches.
+	switch {
+	case len(b.AnyOf) == 0:
+		// removing anyOf entirely widens the accepted values
+	case len(a.AnyOf) == 0:
+		errs = append(errs, fmt.Errorf("%w : %s", ErrAnyOfConstraintAdded, branchSummaries(b.AnyOf)))
+	default:
+		for _, i := range unmatchedBranches(a.AnyOf, b.AnyOf) {
+			errs = append(errs, fmt.Errorf("%w : anyOf[%d] %s", ErrAnyOfAlternativeRemoved, i, branchSummary(&a.AnyOf[i])))
+		}
+	}
+
+	// exactly one oneOf branch has to match, so removed branches narrow the accepted values
+	// and added branches can reject values that now match more than one branch.
+	switch {
+	case len(b.OneOf) == 0:
+		// removing oneOf entirely widens the accepted values
+	case len(a.OneOf) == 0:
+		errs = append(errs, fmt.Errorf("%w : %s", ErrOneOfConstraintAdded, branchSummaries(b.OneOf)))
+	default:
+		for _, i := range unmatchedBranches(a.OneOf, b.OneOf) {
+			errs = append(errs, fmt.Errorf("%w : oneOf[%d] %s", ErrOneOfAlternativeRemoved, i, branchSummary(&a.OneOf[i])))
+		}
+
+		for _, i := range unmatchedBranches(b.OneOf, a.OneOf) {
+			errs = append(errs, fmt.Errorf("%w : oneOf[%d] %s : values matching both this and another alternative are rejected", ErrOneOfAlternativeAdded, i, branchSummary(&b.OneOf[i])))
+		}
+	}
+
+	switch {
+	case b.Not == nil || (a.Not != nil && sameBranch(*a.Not, *b.Not)):
+		// nothing to do
+	case a.Not == nil:
+		errs = append(errs, fmt.Errorf("%w : %s", ErrNotConstraintAdded, branchSummary(b.Not)))
+	default:
+		errs = append(errs, fmt.Errorf("%w : %s -> %s", ErrNotConstraintChanged, branchSummary(a.Not), branchSummary(b.Not)))
+	}
+
+	a.AllOf = nil
+	b.AllOf = nil
+	a.AnyOf = nil
+	b.AnyOf = nil
+	a.OneOf = nil
+	b.OneOf = nil
+	a.Not = nil
+	b.Not = nil
+
+	return validations.HandleErrors(c.Name(), c.enforcement, errs...)
+}
+
+// unmatchedBranches returns the indices of the branches of from that have no
+// counterpart in to that accepts the same values, in the order they appear in from.
+// Each branch in to is only matched once so that duplicated branches are accounted for.
+func unmatchedBranches(from, to []apiextensionsv1.JSONSchemaProps) []int {
+	matched := make([]bool, len(to))
+	unmatched := []int{}
+
+	for i, branch := range from {
+		found := false
+
+		for j := range to {
+			if !matched[j] && sameBranch(branch, to[j]) {
+				matched[j] = true
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			unmatched = append(unmatched, i)
+		}
+	}
+
+	return unmatched
+}
+
+// sameBranch returns whether two branches are semantically equal when
+// ignoring the documentation fields of them and their descendants.
+func sameBranch(a, b apiextensionsv1.JSONSchemaProps) bool {
+	return equality.Semantic.DeepEqual(withoutDocumentation(a), withoutDocumentation(b))
+}
+
+// withoutDocumentation returns a copy of the schema where the description, title,
+// example and externalDocs of the schema and all of its descendants are cleared.
+func withoutDocumentation(s apiextensionsv1.JSONSchemaProps) *apiextensionsv1.JSONSchemaProps {
+	out := s.DeepCopy()
+	clearDocumentation(out)
+
+	return out
+}
+
+// clearDocumentation clears the description, title, example and externalDocs
+// of the schema and all of its descendants in place.
+//
+//nolint:cyclop
+func clearDocumentation(s *apiextensionsv1.JSONSchemaProps) {
+	if s == nil {
+		return
+	}
+
+	s.Description = ""
+	s.Title = ""
+	s.Example = nil
+	s.ExternalDocs = nil
+
+	for _, schemas := range []map[string]apiextensionsv1.JSONSchemaProps{s.Properties, s.PatternProperties, s.Definitions} {
+		for key, schema := range schemas {
+			clearDocumentation(&schema)
+			schemas[key] = schema
+		}
+	}
+
+	for _, branches := range [][]apiextensionsv1.JSONSchemaProps{s.AllOf, s.AnyOf, s.OneOf} {
+		for i := range branches {
+			clearDocumentation(&branches[i])
+		}
+	}
+
+	clearDocumentation(s.Not)
+
+	if s.Items != nil {
+		clearDocumentation(s.Items.Schema)
+
+		for i := range s.Items.JSONSchemas {
+			clearDocumentation(&s.Items.JSONSchemas[i])
+		}
+	}
+
+	if s.AdditionalProperties != nil {
+		clearDocumentation(s.AdditionalProperties.Schema)
+	}
+
+	if s.AdditionalItems != nil {
+		clearDocumentation(s.AdditionalItems.Schema)
+	}
+
+	for _, dependency := range s.Dependencies {
+		clearDocumentation(dependency.Schema)
+	}
+}
+
+// branchSummaries returns the summaries of the provided branches.
+func branchSummaries(branches []apiextensionsv1.JSONSchemaProps) string {
+	summaries := make([]string, 0, len(branches))
+	for i := range branches {
+		summaries = append(summaries, branchSummary(&branches[i]))
+	}
+
+	return "[" + strings.Join(summaries, ", ") + "]"
+}
+
+// maxSummaryValueLength is the maximum length of a value included in a branch summary.
+const maxSummaryValueLength = 40
+
+// branchSummary returns a short summary of a branch listing the keywords it sets.
+// The values of keywords are only included when they are short scalar values,
+// nested schemas and lists are elided so that the summary stays readable.
+func branchSummary(s *apiextensionsv1.JSONSchemaProps) string {
+	out, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Sprintf("%v", s)
+	}
+
+	keywords := map[string]json.RawMessage{}
+	if err := json.Unmarshal(out, &keywords); err != nil {
+		return string(out)
+	}
+
+	parts := make([]string, 0, len(keywords))
+
+	for _, keyword := range slices.Sorted(maps.Keys(keywords)) {
+		value := string(keywords[keyword])
+
+		switch {
+		case strings.HasPrefix(value, "{"):
+			value = "{...}"
+		case strings.HasPrefix(value, "["):
+			value = "[...]"
+		case len([]rune(value)) > maxSummaryValueLength:
+			value = string([]rune(value)[:maxSummaryValueLength]) + "..."
+		}
+
+		parts = append(parts, keyword+": "+value)
+	}
+
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// ErrAllOfConstraintAdded represents an error state when an allOf branch was added to a property.
+var ErrAllOfConstraintAdded = errors.New("allOf constraint added")
+
+// ErrAnyOfConstraintAdded represents an error state when anyOf was added to a property that had none.
+var ErrAnyOfConstraintAdded = errors.New("anyOf constraint added")
+
+// ErrAnyOfAlternativeRemoved represents an error state when an anyOf branch was removed from a property.
+var ErrAnyOfAlternativeRemoved = errors.New("anyOf alternative removed")
+
+// ErrOneOfAlternativeRemoved represents an error state when a oneOf branch was removed from a property.
+var ErrOneOfAlternativeRemoved = errors.New("oneOf alternative removed")
+
+// ErrOneOfConstraintAdded represents an error state when oneOf was added to a property that had none.
+var ErrOneOfConstraintAdded = errors.New("oneOf constraint added")
+
+// ErrOneOfAlternativeAdded represents an error state when a oneOf branch was added to a property.
+var ErrOneOfAlternativeAdded = errors.New("oneOf alternative added")
+
+// ErrNotConstraintAdded represents an error state when a not constraint was added to a property.
+var ErrNotConstraintAdded = errors.New("not constraint added")
+
+// ErrNotConstraintChanged represents an error state when the not constraint of a property changed.
+var ErrNotConstraintChanged = errors.New("not constraint changed")
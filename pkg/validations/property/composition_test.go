@@ -0,0 +1,277 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/crdify/pkg/config"
+	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
+)
+
+func TestComposition(t *testing.T) {
+	minLength := apiextensionsv1.JSONSchemaProps{MinLength: ptr.To[int64](1)}
+	maxLength := apiextensionsv1.JSONSchemaProps{MaxLength: ptr.To[int64](10)}
+	pattern := apiextensionsv1.JSONSchemaProps{Pattern: "^[a-z]+$"}
+
+	testcases := []internaltesting.Testcase[apiextensionsv1.JSONSchemaProps]{
+		{
+			Name: "no diff, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				AllOf: []apiextensionsv1.JSONSchemaProps{minLength, maxLength},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				AllOf: []apiextensionsv1.JSONSchemaProps{minLength, maxLength},
+			},
+			Flagged:              false,
+			ComparableValidation: &Composition{},
+		},
+		{
+			Name: "allOf branches reordered, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				AllOf: []apiextensionsv1.JSONSchemaProps{minLength, maxLength},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				AllOf: []apiextensionsv1.JSONSchemaProps{maxLength, minLength},
+			},
+			Flagged:              false,
+			ComparableValidation: &Composition{},
+		},
+		{
+			Name: "allOf branch inserted, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				AllOf: []apiextensionsv1.JSONSchemaProps{minLength, maxLength},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				AllOf: []apiextensionsv1.JSONSchemaProps{pattern, minLength, maxLength},
+			},
+			Flagged:              true,
+			ComparableValidation: &Composition{},
+		},
+		{
+			Name: "allOf branch removed, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				AllOf: []apiextensionsv1.JSONSchemaProps{minLength, maxLength},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				AllOf: []apiextensionsv1.JSONSchemaProps{maxLength},
+			},
+			Flagged:              false,
+			ComparableValidation: &Composition{},
+		},
+		{
+			Name: "anyOf alternative removed, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				AnyOf: []apiextensionsv1.JSONSchemaProps{minLength, maxLength},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				AnyOf: []apiextensionsv1.JSONSchemaProps{maxLength},
+			},
+			Flagged:              true,
+			ComparableValidation: &Composition{},
+		},
+		{
+			Name:                 "anyOf added, flagged",
+			Old:                  &apiextensionsv1.JSONSchemaProps{},
+			New:                  &apiextensionsv1.JSONSchemaProps{AnyOf: []apiextensionsv1.JSONSchemaProps{pattern, maxLength}},
+			Flagged:              true,
+			ComparableValidation: &Composition{},
+		},
+		{
+			Name:                 "anyOf removed, not flagged",
+			Old:                  &apiextensionsv1.JSONSchemaProps{AnyOf: []apiextensionsv1.JSONSchemaProps{pattern, maxLength}},
+			New:                  &apiextensionsv1.JSONSchemaProps{},
+			Flagged:              false,
+			ComparableValidation: &Composition{},
+		},
+		{
+			Name: "anyOf alternative added, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				AnyOf: []apiextensionsv1.JSONSchemaProps{minLength},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				AnyOf: []apiextensionsv1.JSONSchemaProps{maxLength, minLength},
+			},
+			Flagged:              false,
+			ComparableValidation: &Composition{},
+		},
+		{
+			Name: "duplicated anyOf alternative removed, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				AnyOf: []apiextensionsv1.JSONSchemaProps{minLength, minLength},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				AnyOf: []apiextensionsv1.JSONSchemaProps{minLength},
+			},
+			Flagged:              true,
+			ComparableValidation: &Composition{},
+		},
+		{
+			Name: "oneOf alternative removed, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				OneOf: []apiextensionsv1.JSONSchemaProps{minLength, pattern},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				OneOf: []apiextensionsv1.JSONSchemaProps{pattern},
+			},
+			Flagged:              true,
+			ComparableValidation: &Composition{},
+		},
+		{
+			Name: "oneOf alternatives reordered, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				OneOf: []apiextensionsv1.JSONSchemaProps{minLength, pattern},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				OneOf: []apiextensionsv1.JSONSchemaProps{pattern, minLength},
+			},
+			Flagged:              false,
+			ComparableValidation: &Composition{},
+		},
+		{
+			Name: "oneOf alternative added, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				OneOf: []apiextensionsv1.JSONSchemaProps{minLength, pattern},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				OneOf: []apiextensionsv1.JSONSchemaProps{maxLength, pattern, minLength},
+			},
+			Flagged:              true,
+			ComparableValidation: &Composition{},
+		},
+		{
+			Name:                 "oneOf added, flagged",
+			Old:                  &apiextensionsv1.JSONSchemaProps{},
+			New:                  &apiextensionsv1.JSONSchemaProps{OneOf: []apiextensionsv1.JSONSchemaProps{minLength, pattern}},
+			Flagged:              true,
+			ComparableValidation: &Composition{},
+		},
+		{
+			Name:                 "oneOf removed, not flagged",
+			Old:                  &apiextensionsv1.JSONSchemaProps{OneOf: []apiextensionsv1.JSONSchemaProps{minLength, pattern}},
+			New:                  &apiextensionsv1.JSONSchemaProps{},
+			Flagged:              false,
+			ComparableValidation: &Composition{},
+		},
+		{
+			Name: "allOf branch documentation changed, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				AllOf: []apiextensionsv1.JSONSchemaProps{{Description: "old", MaxLength: ptr.To[int64](10)}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				AllOf: []apiextensionsv1.JSONSchemaProps{{Description: "new", Title: "title", MaxLength: ptr.To[int64](10)}},
+			},
+			Flagged:              false,
+			ComparableValidation: &Composition{},
+		},
+		{
+			Name: "nested documentation changed in not, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Not: &apiextensionsv1.JSONSchemaProps{Properties: map[string]apiextensionsv1.JSONSchemaProps{"name": {Type: "string", Description: "old"}}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Not: &apiextensionsv1.JSONSchemaProps{Properties: map[string]apiextensionsv1.JSONSchemaProps{"name": {Type: "string", Description: "new"}}},
+			},
+			Flagged:              false,
+			ComparableValidation: &Composition{},
+		},
+		{
+			Name:                 "not added, flagged",
+			Old:                  &apiextensionsv1.JSONSchemaProps{},
+			New:                  &apiextensionsv1.JSONSchemaProps{Not: &pattern},
+			Flagged:              true,
+			ComparableValidation: &Composition{},
+		},
+		{
+			Name:                 "not removed, not flagged",
+			Old:                  &apiextensionsv1.JSONSchemaProps{Not: &pattern},
+			New:                  &apiextensionsv1.JSONSchemaProps{},
+			Flagged:              false,
+			ComparableValidation: &Composition{},
+		},
+		{
+			Name:                 "not changed, flagged",
+			Old:                  &apiextensionsv1.JSONSchemaProps{Not: &pattern},
+			New:                  &apiextensionsv1.JSONSchemaProps{Not: &minLength},
+			Flagged:              true,
+			ComparableValidation: &Composition{},
+		},
+		{
+			Name: "different field changed, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				ID: "foo",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				ID: "bar",
+			},
+			Flagged:              false,
+			ComparableValidation: &Composition{},
+		},
+	}
+
+	internaltesting.RunTestcases(t, testcases...)
+}
+
+func TestCompositionChangedBranch(t *testing.T) {
+	val := &Composition{}
+	val.SetEnforcement(config.EnforcementPolicyError)
+
+	result := val.Compare(
+		&apiextensionsv1.JSONSchemaProps{AllOf: []apiextensionsv1.JSONSchemaProps{{MaxLength: ptr.To[int64](10)}}},
+		&apiextensionsv1.JSONSchemaProps{AllOf: []apiextensionsv1.JSONSchemaProps{{MaxLength: ptr.To[int64](5)}}},
+	)
+
+	if len(result.Errors) != 1 || result.Errors[0] != `allOf constraint added : allOf[0] {maxLength: 5}` {
+		t.Fatalf("expected the changed branch to be reported as an added constraint, got %v", result.Errors)
+	}
+}
+
+func TestCompositionAnyOfAdded(t *testing.T) {
+	val := &Composition{}
+	val.SetEnforcement(config.EnforcementPolicyError)
+
+	result := val.Compare(
+		&apiextensionsv1.JSONSchemaProps{Type: "string"},
+		&apiextensionsv1.JSONSchemaProps{Type: "string", AnyOf: []apiextensionsv1.JSONSchemaProps{{Pattern: "^[a-z]+$"}, {MaxLength: ptr.To[int64](3)}}},
+	)
+
+	expected := `anyOf constraint added : [{pattern: "^[a-z]+$"}, {maxLength: 3}]`
+	if len(result.Errors) != 1 || result.Errors[0] != expected {
+		t.Fatalf("expected %q, got %v", expected, result.Errors)
+	}
+}
+
+func TestCompositionSummarizesBranches(t *testing.T) {
+	val := &Composition{}
+	val.SetEnforcement(config.EnforcementPolicyError)
+
+	result := val.Compare(
+		&apiextensionsv1.JSONSchemaProps{},
+		&apiextensionsv1.JSONSchemaProps{Not: &apiextensionsv1.JSONSchemaProps{
+			Description: "values that are reserved for internal use by the controller",
+			Required:    []string{"name"},
+			Properties: map[string]apiextensionsv1.JSONSchemaProps{
+				"name": {Type: "string"},
+			},
+		}},
+	)
+
+	expected := `not constraint added : {description: "values that are reserved for internal u..., properties: {...}, required: [...]}`
+	if len(result.Errors) != 1 || result.Errors[0] != expected {
+		t.Fatalf("expected %q, got %v", expected, result.Errors)
+	}
+}
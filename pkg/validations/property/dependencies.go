@@ -0,0 +1,160 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+)
+
+var (
+	_ validations.Validation                                  = (*Dependencies)(nil)
+	_ validations.Comparator[apiextensionsv1.JSONSchemaProps] = (*Dependencies)(nil)
+)
+
+const dependenciesValidationName = "dependencies"
+
+// RegisterDependencies registers the Dependencies validation
+// with the provided validation registry.
+func RegisterDependencies(registry validations.Registry) {
+	registry.Register(dependenciesValidationName, dependenciesFactory)
+}
+
+// dependenciesFactory is a function used to initialize a Dependencies validation
+// implementation based on the provided configuration.
+func dependenciesFactory(cfg map[string]interface{}) (validations.Validation, error) {
+	dependenciesCfg := &DependenciesConfig{}
+
+	err := ConfigToType(cfg, dependenciesCfg)
+	if err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	err = ValidateDependenciesConfig(dependenciesCfg)
+	if err != nil {
+		return nil, fmt.Errorf("validating dependencies config: %w", err)
+	}
+
+	return &Dependencies{DependenciesConfig: *dependenciesCfg}, nil
+}
+
+// ValidateDependenciesConfig ensures provided DependenciesConfig is valid and defaults missing values.
+func ValidateDependenciesConfig(in *DependenciesConfig) error {
+	if in == nil {
+		return nil
+	}
+
+	switch in.RemovalPolicy {
+	case DependenciesRemovalPolicyAllow, DependenciesRemovalPolicyDisallow:
+		// valid entries
+	case DependenciesRemovalPolicy(""):
+		in.RemovalPolicy = DependenciesRemovalPolicyDisallow
+	default:
+		return fmt.Errorf("%w : %q (valid values: %q, %q)", errUnknownDependenciesRemovalPolicy, in.RemovalPolicy, DependenciesRemovalPolicyAllow, DependenciesRemovalPolicyDisallow)
+	}
+
+	return nil
+}
+
+var errUnknownDependenciesRemovalPolicy = errors.New("unknown removal policy")
+
+// DependenciesRemovalPolicy represents how removing a dependency should be evaluated.
+type DependenciesRemovalPolicy string
+
+const (
+	// DependenciesRemovalPolicyAllow treats removing a property or schema dependency as compatible.
+	DependenciesRemovalPolicyAllow DependenciesRemovalPolicy = "Allow"
+	// DependenciesRemovalPolicyDisallow treats removing a property or schema dependency as incompatible.
+	DependenciesRemovalPolicyDisallow DependenciesRemovalPolicy = "Disallow"
+)
+
+// DependenciesConfig contains additional configuration for the Dependencies validation.
+type DependenciesConfig struct {
+	// RemovalPolicy dictates whether removing a property or schema dependency is compatible.
+	// Allowed values are Allow and Disallow. Defaults to Disallow.
+	RemovalPolicy DependenciesRemovalPolicy `json:"removalPolicy,omitempty"`
+}
+
+// Dependencies is a Validation that can be used to identify
+// incompatible changes to the dependencies of CRD properties.
+type Dependencies struct {
+	DependenciesConfig
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the Dependencies validation.
+func (d *Dependencies) Name() string {
+	return dependenciesValidationName
+}
+
+// SetEnforcement sets the EnforcementPolicy for the Dependencies validation.
+func (d *Dependencies) SetEnforcement(policy config.EnforcementPolicy) {
+	d.enforcement = policy
+}
+
+// Compare compares an old and a new JSONSchemaProps, checking for property and schema dependencies that were added or removed.
+// Changes to the schema of a schema dependency are evaluated as a property of their own.
+// In order for callers to determine if diffs to a JSONSchemaProps have been handled by this validation
+// the JSONSchemaProps.Dependencies field will be reset to 'nil' as part of this method.
+// It is highly recommended that only copies of the JSONSchemaProps to compare are provided to this method
+// to prevent unintentional modifications.
+func (d *Dependencies) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.ComparisonResult {
+	errs := []error{}
+
+	for _, name := range sets.List(sets.KeySet(a.Dependencies).Union(sets.KeySet(b.Dependencies))) {
+		oldDependency := a.Dependencies[name]
+		newDependency := b.Dependencies[name]
+
+		oldProperties := sets.New(oldDependency.Property...)
+		newProperties := sets.New(newDependency.Property...)
+
+		if added := sets.List(newProperties.Difference(oldProperties)); len(added) > 0 {
+			errs = append(errs, fmt.Errorf("%w : %q : objects setting %q must now also set %v", ErrPropertyDependencyAdded, name, name, added))
+		}
+
+		if removed := sets.List(oldProperties.Difference(newProperties)); len(removed) > 0 && d.RemovalPolicy != DependenciesRemovalPolicyAllow {
+			errs = append(errs, fmt.Errorf("%w : %q : objects setting %q no longer need to set %v", ErrPropertyDependencyRemoved, name, name, removed))
+		}
+
+		switch {
+		case oldDependency.Schema == nil && newDependency.Schema != nil:
+			errs = append(errs, fmt.Errorf("%w : %q : objects setting %q must now match the dependency schema", ErrSchemaDependencyAdded, name, name))
+		case oldDependency.Schema != nil && newDependency.Schema == nil && d.RemovalPolicy != DependenciesRemovalPolicyAllow:
+			errs = append(errs, fmt.Errorf("%w : %q : objects setting %q no longer need to match the dependency schema", ErrSchemaDependencyRemoved, name, name))
+		}
+	}
+
+	a.Dependencies = nil
+	b.Dependencies = nil
+
+	return validations.HandleErrors(d.Name(), d.enforcement, errs...)
+}
+
+// ErrPropertyDependencyAdded represents an error state when properties were added to a property dependency.
+var ErrPropertyDependencyAdded = errors.New("property dependency added")
+
+// ErrPropertyDependencyRemoved represents an error state when properties were removed from a property dependency.
+var ErrPropertyDependencyRemoved = errors.New("property dependency removed")
+
+// ErrSchemaDependencyAdded represents an error state when a schema dependency was added.
+var ErrSchemaDependencyAdded = errors.New("schema dependency added")
+
+// ErrSchemaDependencyRemoved represents an error state when a schema dependency was removed.
+var ErrSchemaDependencyRemoved = errors.New("schema dependency removed")
@@ -0,0 +1,195 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/crdify/pkg/config"
+	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
+)
+
+func TestDependencies(t *testing.T) {
+	testcases := []internaltesting.Testcase[apiextensionsv1.JSONSchemaProps]{
+		{
+			Name: "no diff, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Dependencies: apiextensionsv1.JSONSchemaDependencies{"foo": {Property: []string{"bar"}}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Dependencies: apiextensionsv1.JSONSchemaDependencies{"foo": {Property: []string{"bar"}}},
+			},
+			Flagged:              false,
+			ComparableValidation: &Dependencies{},
+		},
+		{
+			Name: "property dependency reordered, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Dependencies: apiextensionsv1.JSONSchemaDependencies{"foo": {Property: []string{"bar", "baz"}}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Dependencies: apiextensionsv1.JSONSchemaDependencies{"foo": {Property: []string{"baz", "bar"}}},
+			},
+			Flagged:              false,
+			ComparableValidation: &Dependencies{},
+		},
+		{
+			Name: "property dependency added, flagged",
+			Old:  &apiextensionsv1.JSONSchemaProps{},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Dependencies: apiextensionsv1.JSONSchemaDependencies{"foo": {Property: []string{"bar"}}},
+			},
+			Flagged:              true,
+			ComparableValidation: &Dependencies{},
+		},
+		{
+			Name: "property removed from property dependency, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Dependencies: apiextensionsv1.JSONSchemaDependencies{"foo": {Property: []string{"bar", "baz"}}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Dependencies: apiextensionsv1.JSONSchemaDependencies{"foo": {Property: []string{"bar"}}},
+			},
+			Flagged:              true,
+			ComparableValidation: &Dependencies{},
+		},
+		{
+			Name: "property dependency removed, removal policy set to Allow, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Dependencies: apiextensionsv1.JSONSchemaDependencies{"foo": {Property: []string{"bar"}}},
+			},
+			New:     &apiextensionsv1.JSONSchemaProps{},
+			Flagged: false,
+			ComparableValidation: &Dependencies{
+				DependenciesConfig: DependenciesConfig{RemovalPolicy: DependenciesRemovalPolicyAllow},
+			},
+		},
+		{
+			Name: "schema dependency added, flagged",
+			Old:  &apiextensionsv1.JSONSchemaProps{},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Dependencies: apiextensionsv1.JSONSchemaDependencies{"foo": {Schema: &apiextensionsv1.JSONSchemaProps{Required: []string{"bar"}}}},
+			},
+			Flagged:              true,
+			ComparableValidation: &Dependencies{},
+		},
+		{
+			Name: "schema dependency removed, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Dependencies: apiextensionsv1.JSONSchemaDependencies{"foo": {Schema: &apiextensionsv1.JSONSchemaProps{Required: []string{"bar"}}}},
+			},
+			New:                  &apiextensionsv1.JSONSchemaProps{},
+			Flagged:              true,
+			ComparableValidation: &Dependencies{},
+		},
+		{
+			Name: "schema of schema dependency changed, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Dependencies: apiextensionsv1.JSONSchemaDependencies{"foo": {Schema: &apiextensionsv1.JSONSchemaProps{Required: []string{"bar"}}}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Dependencies: apiextensionsv1.JSONSchemaDependencies{"foo": {Schema: &apiextensionsv1.JSONSchemaProps{Required: []string{"baz"}}}},
+			},
+			Flagged:              false,
+			ComparableValidation: &Dependencies{},
+		},
+		{
+			Name: "different field changed, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				ID: "foo",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				ID: "bar",
+			},
+			Flagged:              false,
+			ComparableValidation: &Dependencies{},
+		},
+	}
+
+	internaltesting.RunTestcases(t, testcases...)
+}
+
+func TestDependenciesChangedKind(t *testing.T) {
+	val := &Dependencies{}
+	val.SetEnforcement(config.EnforcementPolicyError)
+
+	result := val.Compare(
+		&apiextensionsv1.JSONSchemaProps{Dependencies: apiextensionsv1.JSONSchemaDependencies{"foo": {Property: []string{"bar"}}}},
+		&apiextensionsv1.JSONSchemaProps{Dependencies: apiextensionsv1.JSONSchemaDependencies{"foo": {Schema: &apiextensionsv1.JSONSchemaProps{Required: []string{"bar"}}}}},
+	)
+
+	expected := []string{
+		`property dependency removed : "foo" : objects setting "foo" no longer need to set [bar]`,
+		`schema dependency added : "foo" : objects setting "foo" must now match the dependency schema`,
+	}
+
+	if !slices.Equal(result.Errors, expected) {
+		t.Fatalf("expected errors %q, got %q", expected, result.Errors)
+	}
+}
+
+func TestValidateDependenciesConfig(t *testing.T) {
+	testcases := []struct {
+		name              string
+		cfg               *DependenciesConfig
+		wantErr           error
+		wantRemovalPolicy DependenciesRemovalPolicy
+	}{
+		{
+			name: "nil config",
+			cfg:  nil,
+		},
+		{
+			name:              "defaults removal policy",
+			cfg:               &DependenciesConfig{},
+			wantRemovalPolicy: DependenciesRemovalPolicyDisallow,
+		},
+		{
+			name:              "allows valid removal policies",
+			cfg:               &DependenciesConfig{RemovalPolicy: DependenciesRemovalPolicyAllow},
+			wantRemovalPolicy: DependenciesRemovalPolicyAllow,
+		},
+		{
+			name:    "invalid removal policy",
+			cfg:     &DependenciesConfig{RemovalPolicy: "invalid"},
+			wantErr: errUnknownDependenciesRemovalPolicy,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateDependenciesConfig(tc.cfg)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tc.cfg != nil && tc.cfg.RemovalPolicy != tc.wantRemovalPolicy {
+				t.Fatalf("expected removal policy %q, got %q", tc.wantRemovalPolicy, tc.cfg.RemovalPolicy)
+			}
+		})
+	}
+}
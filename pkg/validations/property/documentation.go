@@ -0,0 +1,174 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+)
+
+var (
+	_ validations.Validation                                  = (*Documentation)(nil)
+	_ validations.Comparator[apiextensionsv1.JSONSchemaProps] = (*Documentation)(nil)
+)
+
+const documentationValidationName = "documentation"
+
+// RegisterDocumentation registers the Documentation validation
+// with the provided validation registry.
+func RegisterDocumentation(registry validations.Registry) {
+	registry.Register(documentationValidationName, documentationFactory)
+}
+
+// documentationFactory is a function used to initialize a Documentation validation
+// implementation based on the provided configuration.
+func documentationFactory(cfg map[string]interface{}) (validations.Validation, error) {
+	documentationCfg := &DocumentationConfig{}
+
+	err := ConfigToType(cfg, documentationCfg)
+	if err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	err = ValidateDocumentationConfig(documentationCfg)
+	if err != nil {
+		return nil, fmt.Errorf("validating documentation config: %w", err)
+	}
+
+	return &Documentation{DocumentationConfig: *documentationCfg}, nil
+}
+
+// ValidateDocumentationConfig ensures provided DocumentationConfig is valid and defaults missing values.
+func ValidateDocumentationConfig(in *DocumentationConfig) error {
+	if in == nil {
+		return nil
+	}
+
+	switch in.ChangePolicy {
+	case DocumentationChangePolicyIgnore, DocumentationChangePolicyReport:
+		// valid entries
+	case DocumentationChangePolicy(""):
+		in.ChangePolicy = DocumentationChangePolicyIgnore
+	default:
+		return fmt.Errorf("%w : %q (valid values: %q, %q)", errUnknownDocumentationChangePolicy, in.ChangePolicy, DocumentationChangePolicyIgnore, DocumentationChangePolicyReport)
+	}
+
+	return nil
+}
+
+var errUnknownDocumentationChangePolicy = errors.New("unknown change policy")
+
+// DocumentationChangePolicy represents how changes to the documentation fields of a property should be reported.
+type DocumentationChangePolicy string
+
+const (
+	// DocumentationChangePolicyIgnore does not report changes to documentation fields.
+	DocumentationChangePolicyIgnore DocumentationChangePolicy = "Ignore"
+	// DocumentationChangePolicyReport reports changes to documentation fields as warnings.
+	DocumentationChangePolicyReport DocumentationChangePolicy = "Report"
+)
+
+// DocumentationConfig contains additional configuration for the Documentation validation.
+type DocumentationConfig struct {
+	// ChangePolicy dictates whether changes to the title, example and externalDocs of a property are reported.
+	// Allowed values are Ignore and Report. When set to Report, changes are reported as warnings
+	// and never as errors, because they never affect compatibility. Defaults to Ignore.
+	ChangePolicy DocumentationChangePolicy `json:"changePolicy,omitempty"`
+}
+
+// Documentation is a Validation that handles changes to the
+// documentation-only fields of CRD properties, which never
+// affect compatibility.
+type Documentation struct {
+	DocumentationConfig
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the Documentation validation.
+func (d *Documentation) Name() string {
+	return documentationValidationName
+}
+
+// SetEnforcement sets the EnforcementPolicy for the Documentation validation.
+func (d *Documentation) SetEnforcement(policy config.EnforcementPolicy) {
+	d.enforcement = policy
+}
+
+// Compare compares an old and a new JSONSchemaProps, checking for changes to the title, example and externalDocs of a property.
+// In order for callers to determine if diffs to a JSONSchemaProps have been handled by this validation
+// the JSONSchemaProps.Title, JSONSchemaProps.Example and JSONSchemaProps.ExternalDocs fields will be reset
+// to their zero values as part of this method.
+// It is highly recommended that only copies of the JSONSchemaProps to compare are provided to this method
+// to prevent unintentional modifications.
+func (d *Documentation) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.ComparisonResult {
+	changes := []error{}
+
+	if a.Title != b.Title {
+		changes = append(changes, fmt.Errorf("%w : %q -> %q", ErrTitleChanged, a.Title, b.Title))
+	}
+
+	if !equality.Semantic.DeepEqual(a.Example, b.Example) {
+		changes = append(changes, fmt.Errorf("%w : %s -> %s", ErrExampleChanged, exampleString(a.Example), exampleString(b.Example)))
+	}
+
+	if !equality.Semantic.DeepEqual(a.ExternalDocs, b.ExternalDocs) {
+		changes = append(changes, fmt.Errorf("%w : %s -> %s", ErrExternalDocsChanged, externalDocsString(a.ExternalDocs), externalDocsString(b.ExternalDocs)))
+	}
+
+	a.Title = ""
+	b.Title = ""
+	a.Example = nil
+	b.Example = nil
+	a.ExternalDocs = nil
+	b.ExternalDocs = nil
+
+	if d.ChangePolicy != DocumentationChangePolicyReport {
+		return validations.HandleErrors(d.Name(), d.enforcement)
+	}
+
+	return validations.HandleErrorsAndWarnings(d.Name(), d.enforcement, nil, changes)
+}
+
+// exampleString returns a string representation of an example for use in messages.
+func exampleString(example *apiextensionsv1.JSON) string {
+	if example == nil {
+		return "unset"
+	}
+
+	return string(example.Raw)
+}
+
+// externalDocsString returns a string representation of external documentation for use in messages.
+func externalDocsString(docs *apiextensionsv1.ExternalDocumentation) string {
+	if docs == nil {
+		return "unset"
+	}
+
+	return fmt.Sprintf("{description: %q, url: %q}", docs.Description, docs.URL)
+}
+
+// ErrTitleChanged represents a state where the title of a property was changed.
+var ErrTitleChanged = errors.New("title changed")
+
+// ErrExampleChanged represents a state where the example of a property was changed.
+var ErrExampleChanged = errors.New("example changed")
+
+// ErrExternalDocsChanged represents a state where the external documentation of a property was changed.
+var ErrExternalDocsChanged = errors.New("external docs changed")
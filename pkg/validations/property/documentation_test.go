@@ -0,0 +1,167 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/crdify/pkg/config"
+	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
+)
+
+func TestDocumentation(t *testing.T) {
+	testcases := []internaltesting.Testcase[apiextensionsv1.JSONSchemaProps]{
+		{
+			Name: "no diff, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Title: "foo",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Title: "foo",
+			},
+			Flagged:              false,
+			ComparableValidation: &Documentation{},
+		},
+		{
+			Name: "title changed, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Title: "foo",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Title: "bar",
+			},
+			Flagged:              false,
+			ComparableValidation: &Documentation{},
+		},
+		{
+			Name: "example and external docs changed, change policy set to Ignore, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Example:      &apiextensionsv1.JSON{Raw: []byte(`"foo"`)},
+				ExternalDocs: &apiextensionsv1.ExternalDocumentation{URL: "https://example.com/foo"},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Example:      &apiextensionsv1.JSON{Raw: []byte(`"bar"`)},
+				ExternalDocs: &apiextensionsv1.ExternalDocumentation{URL: "https://example.com/bar"},
+			},
+			Flagged: false,
+			ComparableValidation: &Documentation{
+				DocumentationConfig: DocumentationConfig{
+					ChangePolicy: DocumentationChangePolicyIgnore,
+				},
+			},
+		},
+	}
+
+	internaltesting.RunTestcases(t, testcases...)
+}
+
+func TestDocumentationReportsChangesAsWarnings(t *testing.T) {
+	// Compare resets the compared fields, so every comparison gets its own schemas
+	schemas := func() (*apiextensionsv1.JSONSchemaProps, *apiextensionsv1.JSONSchemaProps) {
+		return &apiextensionsv1.JSONSchemaProps{
+			Title:   "foo",
+			Example: &apiextensionsv1.JSON{Raw: []byte(`"foo"`)},
+		}, &apiextensionsv1.JSONSchemaProps{
+			Title:        "bar",
+			Example:      &apiextensionsv1.JSON{Raw: []byte(`"foo"`)},
+			ExternalDocs: &apiextensionsv1.ExternalDocumentation{Description: "docs", URL: "https://example.com"},
+		}
+	}
+
+	expected := []string{
+		`title changed : "foo" -> "bar"`,
+		`external docs changed : unset -> {description: "docs", url: "https://example.com"}`,
+	}
+
+	val := &Documentation{DocumentationConfig: DocumentationConfig{ChangePolicy: DocumentationChangePolicyReport}}
+
+	for _, policy := range []config.EnforcementPolicy{config.EnforcementPolicyError, config.EnforcementPolicyWarn} {
+		t.Run(string(policy), func(t *testing.T) {
+			val.SetEnforcement(policy)
+
+			result := val.Compare(schemas())
+			if len(result.Errors) > 0 {
+				t.Fatalf("expected no errors, got %q", result.Errors)
+			}
+
+			if !slices.Equal(result.Warnings, expected) {
+				t.Fatalf("expected warnings %q, got %q", expected, result.Warnings)
+			}
+		})
+	}
+
+	t.Run(string(config.EnforcementPolicyNone), func(t *testing.T) {
+		val.SetEnforcement(config.EnforcementPolicyNone)
+
+		result := val.Compare(schemas())
+		if !result.IsZero() {
+			t.Fatalf("expected no findings, got %v", result)
+		}
+	})
+}
+
+func TestValidateDocumentationConfig(t *testing.T) {
+	testcases := []struct {
+		name             string
+		cfg              *DocumentationConfig
+		wantErr          error
+		wantChangePolicy DocumentationChangePolicy
+	}{
+		{
+			name: "nil config",
+			cfg:  nil,
+		},
+		{
+			name:             "defaults change policy",
+			cfg:              &DocumentationConfig{},
+			wantChangePolicy: DocumentationChangePolicyIgnore,
+		},
+		{
+			name:             "allows valid change policies",
+			cfg:              &DocumentationConfig{ChangePolicy: DocumentationChangePolicyReport},
+			wantChangePolicy: DocumentationChangePolicyReport,
+		},
+		{
+			name:    "invalid change policy",
+			cfg:     &DocumentationConfig{ChangePolicy: "invalid"},
+			wantErr: errUnknownDocumentationChangePolicy,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateDocumentationConfig(tc.cfg)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tc.cfg != nil && tc.cfg.ChangePolicy != tc.wantChangePolicy {
+				t.Fatalf("expected change policy %q, got %q", tc.wantChangePolicy, tc.cfg.ChangePolicy)
+			}
+		})
+	}
+}
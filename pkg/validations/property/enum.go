@@ -15,9 +15,12 @@
 package property
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"slices"
+	"math/big"
+	"strconv"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -60,7 +63,8 @@ func enumFactory(cfg map[string]interface{}) (validations.Validation, error) {
 // setting default values where appropriate.
 // Currently the defaulting behavior defaults the
 // EnumConfig.AdditionPolicy to AdditionPolicyDisallow
-// if it is set to the empty string ("").
+// and the EnumConfig.RemovalPolicy to RemovalPolicyDisallow
+// if they are set to the empty string ("").
 func ValidateEnumConfig(in *EnumConfig) error {
 	if in == nil {
 		// nothing to validate
@@ -77,10 +81,23 @@ func ValidateEnumConfig(in *EnumConfig) error {
 		return fmt.Errorf("%w : %q", errUnknownAdditionPolicy, in.AdditionPolicy)
 	}
 
+	switch in.RemovalPolicy {
+	case RemovalPolicyAllow, RemovalPolicyDisallow:
+		// do nothing, valid case
+	case RemovalPolicy(""):
+		// default to disallow
+		in.RemovalPolicy = RemovalPolicyDisallow
+	default:
+		return fmt.Errorf("%w : %q", errUnknownRemovalPolicy, in.RemovalPolicy)
+	}
+
 	return nil
 }
 
-var errUnknownAdditionPolicy = errors.New("unknown addition policy")
+var (
+	errUnknownAdditionPolicy = errors.New("unknown addition policy")
+	errUnknownRemovalPolicy  = errors.New("unknown removal policy")
+)
 
 // AdditionPolicy is used to represent how the Enum validation
 // should determine compatibility of adding new enum values to an
@@ -97,6 +114,21 @@ const (
 	AdditionPolicyDisallow AdditionPolicy = "Disallow"
 )
 
+// RemovalPolicy is used to represent how the Enum validation
+// should determine compatibility of removing enum values from an
+// existing enum constraint.
+type RemovalPolicy string
+
+const (
+	// RemovalPolicyAllow signals that removing enum values from
+	// an existing enum constraint should be considered a compatible change.
+	RemovalPolicyAllow RemovalPolicy = "Allow"
+
+	// RemovalPolicyDisallow signals that removing enum values from
+	// an existing enum constraint should be considered an incompatible change.
+	RemovalPolicyDisallow RemovalPolicy = "Disallow"
+)
+
 // EnumConfig contains additional configurations for the Enum validation.
 type EnumConfig struct {
 	// additionPolicy is how adding enums to an existing set of
@@ -108,6 +140,16 @@ type EnumConfig struct {
 	// set of enums will be flagged.
 	// Defaults to Disallow.
 	AdditionPolicy AdditionPolicy `json:"additionPolicy,omitempty"`
+
+	// removalPolicy is how removing enums from an existing set of
+	// enums should be treated.
+	// Allowed values are Allow and Disallow.
+	// When set to Allow, removing values from an existing set
+	// of enums will not be flagged.
+	// When set to Disallow, removing values from an existing
+	// set of enums will be flagged.
+	// Defaults to Disallow.
+	RemovalPolicy RemovalPolicy `json:"removalPolicy,omitempty"`
 }
 
 // Enum is a Validation that can be used to identify
@@ -132,45 +174,94 @@ func (e *Enum) SetEnforcement(policy config.EnforcementPolicy) {
 }
 
 // Compare compares an old and a new JSONSchemaProps, checking for incompatible changes to the enum constraints of a property.
+// Enum values are compared as a set of normalized JSON values, so reordering or reformatting enum values is not flagged.
 // In order for callers to determine if diffs to a JSONSchemaProps have been handled by this validation
 // the JSONSchemaProps.Enum field will be reset to 'nil' as part of this method.
 // It is highly recommended that only copies of the JSONSchemaProps to compare are provided to this method
 // to prevent unintentional modifications.
 func (e *Enum) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.ComparisonResult {
-	oldEnums := sets.New[string]()
-	for _, json := range a.Enum {
-		oldEnums.Insert(string(json.Raw))
-	}
-
-	newEnums := sets.New[string]()
-	for _, json := range b.Enum {
-		newEnums.Insert(string(json.Raw))
-	}
+	oldEnums := normalizedEnums(a.Enum)
+	newEnums := normalizedEnums(b.Enum)
 
 	removedEnums := oldEnums.Difference(newEnums)
 	addedEnums := newEnums.Difference(oldEnums)
 
-	var err error
-
-	switch {
-	case oldEnums.Len() == 0 && newEnums.Len() > 0:
-		newEnumSlice := newEnums.UnsortedList()
-		slices.Sort(newEnumSlice)
-		err = fmt.Errorf("%w : %v", ErrNetNewEnumConstraint, newEnumSlice)
-	case removedEnums.Len() > 0:
-		removedEnumSlice := removedEnums.UnsortedList()
-		slices.Sort(removedEnumSlice)
-		err = fmt.Errorf("%w : %v", ErrRemovedEnums, removedEnumSlice)
-	case addedEnums.Len() > 0 && e.AdditionPolicy != AdditionPolicyAllow:
-		addedEnumSlice := addedEnums.UnsortedList()
-		slices.Sort(addedEnumSlice)
-		err = fmt.Errorf("%w : %v", ErrAddedEnums, addedEnumSlice)
+	errs := []error{}
+
+	if oldEnums.Len() == 0 && newEnums.Len() > 0 {
+		errs = append(errs, fmt.Errorf("%w : %v", ErrNetNewEnumConstraint, sets.List(newEnums)))
+	} else {
+		if removedEnums.Len() > 0 && e.RemovalPolicy != RemovalPolicyAllow {
+			errs = append(errs, fmt.Errorf("%w : %v", ErrRemovedEnums, sets.List(removedEnums)))
+		}
+
+		if addedEnums.Len() > 0 && e.AdditionPolicy != AdditionPolicyAllow {
+			errs = append(errs, fmt.Errorf("%w : %v", ErrAddedEnums, sets.List(addedEnums)))
+		}
 	}
 
 	a.Enum = nil
 	b.Enum = nil
 
-	return validations.HandleErrors(e.Name(), e.enforcement, err)
+	return validations.HandleErrors(e.Name(), e.enforcement, errs...)
+}
+
+// normalizedEnums returns the set of the provided enum values, normalized
+// so that values that only differ in their JSON encoding (i.e whitespace,
+// the order of object keys or 1 vs 1.0) are equal.
+// Numbers are decoded without loss of precision, so large integers that
+// can not be represented as a float64 are not considered equal.
+// Values that are not valid JSON are used as they are.
+func normalizedEnums(enums []apiextensionsv1.JSON) sets.Set[string] {
+	normalized := sets.New[string]()
+
+	for _, enum := range enums {
+		decoder := json.NewDecoder(bytes.NewReader(enum.Raw))
+		decoder.UseNumber()
+
+		var value interface{}
+		if err := decoder.Decode(&value); err != nil {
+			normalized.Insert(string(enum.Raw))
+			continue
+		}
+
+		out, err := json.Marshal(normalizedNumbers(value))
+		if err != nil {
+			normalized.Insert(string(enum.Raw))
+			continue
+		}
+
+		normalized.Insert(string(out))
+	}
+
+	return normalized
+}
+
+// normalizedNumbers returns the provided decoded JSON value with all of its numbers
+// rewritten to a canonical representation. Integers are represented exactly,
+// regardless of how they were written (i.e 1, 1.0 and 1e0), other numbers are
+// represented as the closest float64.
+func normalizedNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = normalizedNumbers(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = normalizedNumbers(item)
+		}
+	case json.Number:
+		if r, ok := new(big.Rat).SetString(v.String()); ok && r.IsInt() {
+			return json.Number(r.Num().String())
+		}
+
+		if f, err := v.Float64(); err == nil {
+			return json.Number(strconv.FormatFloat(f, 'g', -1, 64))
+		}
+	}
+
+	return value
 }
 
 var (
@@ -16,9 +16,11 @@ package property
 
 import (
 	"errors"
+	"slices"
 	"testing"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/crdify/pkg/config"
 	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
 )
 
@@ -154,6 +156,105 @@ func TestEnum(t *testing.T) {
 			Flagged:              true,
 			ComparableValidation: &Enum{},
 		},
+		{
+			Name: "removed enum value, removal policy set to Allow, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Enum: []apiextensionsv1.JSON{
+					{
+						Raw: []byte("foo"),
+					},
+					{
+						Raw: []byte("bar"),
+					},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Enum: []apiextensionsv1.JSON{
+					{
+						Raw: []byte("bar"),
+					},
+				},
+			},
+			Flagged: false,
+			ComparableValidation: &Enum{
+				EnumConfig: EnumConfig{
+					RemovalPolicy: RemovalPolicyAllow,
+				},
+			},
+		},
+		{
+			Name: "removed and added enum values, removal policy set to Allow, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Enum: []apiextensionsv1.JSON{
+					{
+						Raw: []byte("foo"),
+					},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Enum: []apiextensionsv1.JSON{
+					{
+						Raw: []byte("bar"),
+					},
+				},
+			},
+			Flagged: true,
+			ComparableValidation: &Enum{
+				EnumConfig: EnumConfig{
+					RemovalPolicy: RemovalPolicyAllow,
+				},
+			},
+		},
+		{
+			Name: "reordered enum values, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Enum: []apiextensionsv1.JSON{
+					{
+						Raw: []byte(`"foo"`),
+					},
+					{
+						Raw: []byte(`"bar"`),
+					},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Enum: []apiextensionsv1.JSON{
+					{
+						Raw: []byte(`"bar"`),
+					},
+					{
+						Raw: []byte(`"foo"`),
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &Enum{},
+		},
+		{
+			Name: "reformatted enum values, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Enum: []apiextensionsv1.JSON{
+					{
+						Raw: []byte(`1`),
+					},
+					{
+						Raw: []byte(`{"a": "x", "b": "y"}`),
+					},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Enum: []apiextensionsv1.JSON{
+					{
+						Raw: []byte(`1.0`),
+					},
+					{
+						Raw: []byte(`{"b":"y","a":"x"}`),
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &Enum{},
+		},
 		{
 			Name: "different field changed, not flagged",
 			Old: &apiextensionsv1.JSONSchemaProps{
@@ -191,32 +292,84 @@ func TestEnum(t *testing.T) {
 	internaltesting.RunTestcases(t, testcases...)
 }
 
+func TestEnumReportsAddedAndRemovedValues(t *testing.T) {
+	val := &Enum{}
+	val.SetEnforcement(config.EnforcementPolicyError)
+
+	result := val.Compare(
+		&apiextensionsv1.JSONSchemaProps{Enum: []apiextensionsv1.JSON{{Raw: []byte(`"foo"`)}, {Raw: []byte(`"bar"`)}}},
+		&apiextensionsv1.JSONSchemaProps{Enum: []apiextensionsv1.JSON{{Raw: []byte(`"bar"`)}, {Raw: []byte(`"qux"`)}, {Raw: []byte(`"baz"`)}}},
+	)
+
+	expected := []string{
+		`allowed enum values removed : ["foo"]`,
+		`allowed enum values added : ["baz" "qux"]`,
+	}
+
+	if !slices.Equal(result.Errors, expected) {
+		t.Fatalf("expected errors %q, got %q", expected, result.Errors)
+	}
+}
+
+func TestEnumComparesLargeIntegersExactly(t *testing.T) {
+	val := &Enum{}
+	val.SetEnforcement(config.EnforcementPolicyError)
+
+	result := val.Compare(
+		&apiextensionsv1.JSONSchemaProps{Enum: []apiextensionsv1.JSON{{Raw: []byte(`9007199254740993`)}, {Raw: []byte(`2.0`)}}},
+		&apiextensionsv1.JSONSchemaProps{Enum: []apiextensionsv1.JSON{{Raw: []byte(`9007199254740992`)}, {Raw: []byte(`2`)}}},
+	)
+
+	expected := []string{
+		`allowed enum values removed : [9007199254740993]`,
+		`allowed enum values added : [9007199254740992]`,
+	}
+
+	if !slices.Equal(result.Errors, expected) {
+		t.Fatalf("expected errors %q, got %q", expected, result.Errors)
+	}
+}
+
 func TestValidateEnumConfig(t *testing.T) {
 	testcases := []struct {
 		name               string
 		cfg                *EnumConfig
 		wantErr            error
 		wantAdditionPolicy AdditionPolicy
+		wantRemovalPolicy  RemovalPolicy
 	}{
 		{
 			name: "nil config",
 			cfg:  nil,
 		},
 		{
-			name:               "defaults addition policy",
+			name:               "defaults addition and removal policies",
 			cfg:                &EnumConfig{},
 			wantAdditionPolicy: AdditionPolicyDisallow,
+			wantRemovalPolicy:  RemovalPolicyDisallow,
 		},
 		{
 			name:               "allows valid addition policies",
 			cfg:                &EnumConfig{AdditionPolicy: AdditionPolicyAllow},
 			wantAdditionPolicy: AdditionPolicyAllow,
+			wantRemovalPolicy:  RemovalPolicyDisallow,
+		},
+		{
+			name:               "allows valid removal policies",
+			cfg:                &EnumConfig{RemovalPolicy: RemovalPolicyAllow},
+			wantAdditionPolicy: AdditionPolicyDisallow,
+			wantRemovalPolicy:  RemovalPolicyAllow,
 		},
 		{
 			name:    "invalid addition policy",
 			cfg:     &EnumConfig{AdditionPolicy: "invalid"},
 			wantErr: errUnknownAdditionPolicy,
 		},
+		{
+			name:    "invalid removal policy",
+			cfg:     &EnumConfig{RemovalPolicy: "invalid"},
+			wantErr: errUnknownRemovalPolicy,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -238,6 +391,10 @@ func TestValidateEnumConfig(t *testing.T) {
 			if tc.cfg != nil && tc.cfg.AdditionPolicy != tc.wantAdditionPolicy {
 				t.Fatalf("expected addition policy %q, got %q", tc.wantAdditionPolicy, tc.cfg.AdditionPolicy)
 			}
+
+			if tc.cfg != nil && tc.cfg.RemovalPolicy != tc.wantRemovalPolicy {
+				t.Fatalf("expected removal policy %q, got %q", tc.wantRemovalPolicy, tc.cfg.RemovalPolicy)
+			}
 		})
 	}
 }
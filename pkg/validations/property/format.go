@@ -0,0 +1,225 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+)
+
+var (
+	_ validations.Validation                                  = (*Format)(nil)
+	_ validations.Comparator[apiextensionsv1.JSONSchemaProps] = (*Format)(nil)
+)
+
+const formatValidationName = "format"
+
+// RegisterFormat registers the Format validation
+// with the provided validation registry.
+func RegisterFormat(registry validations.Registry) {
+	registry.Register(formatValidationName, formatFactory)
+}
+
+// formatFactory is a function used to initialize a Format validation
+// implementation based on the provided configuration.
+func formatFactory(cfg map[string]interface{}) (validations.Validation, error) {
+	formatCfg := &FormatConfig{}
+
+	err := ConfigToType(cfg, formatCfg)
+	if err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	err = ValidateFormatConfig(formatCfg)
+	if err != nil {
+		return nil, fmt.Errorf("validating format config: %w", err)
+	}
+
+	return &Format{FormatConfig: *formatCfg}, nil
+}
+
+// ValidateFormatConfig ensures provided FormatConfig is valid and defaults missing values.
+func ValidateFormatConfig(in *FormatConfig) error {
+	if in == nil {
+		return nil
+	}
+
+	switch in.RemovalPolicy {
+	case FormatRemovalPolicyAllow, FormatRemovalPolicyDisallow:
+		// valid entries
+	case FormatRemovalPolicy(""):
+		in.RemovalPolicy = FormatRemovalPolicyDisallow
+	default:
+		return fmt.Errorf("%w : %q (valid values: %q, %q)", errUnknownFormatRemovalPolicy, in.RemovalPolicy, FormatRemovalPolicyAllow, FormatRemovalPolicyDisallow)
+	}
+
+	for _, transition := range in.AllowedTransitions {
+		if transition.From == "" || transition.To == "" {
+			return fmt.Errorf("%w : %q -> %q", errEmptyFormatTransition, transition.From, transition.To)
+		}
+
+		if !formatWidens(normalizeFormat(transition.From), normalizeFormat(transition.To)) {
+			return fmt.Errorf("%w : %q -> %q", errFormatTransitionNotWidening, transition.From, transition.To)
+		}
+	}
+
+	return nil
+}
+
+var (
+	errUnknownFormatRemovalPolicy  = errors.New("unknown removal policy")
+	errEmptyFormatTransition       = errors.New("format transitions must have a from and a to format")
+	errFormatTransitionNotWidening = errors.New("format transitions must be known widenings")
+)
+
+// FormatRemovalPolicy represents how removing a format from a property should be evaluated.
+type FormatRemovalPolicy string
+
+const (
+	// FormatRemovalPolicyAllow treats removing a format as compatible.
+	FormatRemovalPolicyAllow FormatRemovalPolicy = "Allow"
+	// FormatRemovalPolicyDisallow treats removing a format as incompatible.
+	FormatRemovalPolicyDisallow FormatRemovalPolicy = "Disallow"
+)
+
+// FormatTransition is a change of the format of a property.
+type FormatTransition struct {
+	// From is the format of the property before the change.
+	From string `json:"from"`
+	// To is the format of the property after the change.
+	To string `json:"to"`
+}
+
+// FormatConfig contains additional configuration for the Format validation.
+type FormatConfig struct {
+	// RemovalPolicy dictates whether removing a format from a property is compatible.
+	// Allowed values are Allow and Disallow. Defaults to Disallow.
+	RemovalPolicy FormatRemovalPolicy `json:"removalPolicy,omitempty"`
+
+	// AllowedTransitions is the set of format widenings that are compatible (i.e int32 -> int64).
+	// Only known widenings are allowed, narrowings and changes to unrelated formats are always incompatible.
+	// Adding and removing a format can not be allowed using transitions.
+	// Defaults to no format changes being compatible.
+	AllowedTransitions []FormatTransition `json:"allowedTransitions,omitempty"`
+}
+
+// Format is a Validation that can be used to identify
+// incompatible changes to the format of CRD properties.
+type Format struct {
+	FormatConfig
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the Format validation.
+func (f *Format) Name() string {
+	return formatValidationName
+}
+
+// SetEnforcement sets the EnforcementPolicy for the Format validation.
+func (f *Format) SetEnforcement(policy config.EnforcementPolicy) {
+	f.enforcement = policy
+}
+
+// Compare compares an old and a new JSONSchemaProps, checking for incompatible changes to the format of a property.
+// Format widenings that are part of the configured allowed transitions are not flagged.
+// In order for callers to determine if diffs to a JSONSchemaProps have been handled by this validation
+// the JSONSchemaProps.Format field will be reset to '""' as part of this method.
+// It is highly recommended that only copies of the JSONSchemaProps to compare are provided to this method
+// to prevent unintentional modifications.
+func (f *Format) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.ComparisonResult {
+	var err error
+
+	oldFormat := normalizeFormat(a.Format)
+	newFormat := normalizeFormat(b.Format)
+
+	switch {
+	case oldFormat == newFormat:
+		// nothing to do
+	case oldFormat == "":
+		err = fmt.Errorf("%w : %q -> %q", ErrFormatAdded, a.Format, b.Format)
+	case newFormat == "":
+		if f.RemovalPolicy != FormatRemovalPolicyAllow {
+			err = fmt.Errorf("%w : %q -> %q", ErrFormatRemoved, a.Format, b.Format)
+		}
+	case formatWidens(oldFormat, newFormat):
+		if !f.transitionAllowed(oldFormat, newFormat) {
+			err = fmt.Errorf("%w : %q -> %q", ErrFormatWidened, a.Format, b.Format)
+		}
+	case formatWidens(newFormat, oldFormat):
+		err = fmt.Errorf("%w : %q -> %q", ErrFormatNarrowed, a.Format, b.Format)
+	default:
+		err = fmt.Errorf("%w : %q -> %q", ErrFormatChanged, a.Format, b.Format)
+	}
+
+	a.Format = ""
+	b.Format = ""
+
+	return validations.HandleErrors(f.Name(), f.enforcement, err)
+}
+
+// transitionAllowed returns whether changing the format of a property from the provided
+// format to the provided format is one of the allowed transitions.
+// Both formats are expected to be normalized.
+func (f *Format) transitionAllowed(from, to string) bool {
+	return slices.ContainsFunc(f.AllowedTransitions, func(transition FormatTransition) bool {
+		return normalizeFormat(transition.From) == from && normalizeFormat(transition.To) == to
+	})
+}
+
+// normalizeFormat normalizes a format name the same way
+// the API server does, so that aliases like "date-time"
+// and "datetime" are treated as the same format.
+func normalizeFormat(format string) string {
+	return strings.ReplaceAll(format, "-", "")
+}
+
+// formatWidens returns whether every value accepted by the
+// from format is also accepted by the to format.
+// Both formats are expected to be normalized.
+func formatWidens(from, to string) bool {
+	switch from {
+	case "int32":
+		return to == "int64"
+	case "float":
+		return to == "double"
+	case "uuid3", "uuid4", "uuid5":
+		return to == "uuid"
+	case "isbn10", "isbn13":
+		return to == "isbn"
+	default:
+		return false
+	}
+}
+
+// ErrFormatAdded represents an error state when a format was added to a property.
+var ErrFormatAdded = errors.New("format added")
+
+// ErrFormatRemoved represents an error state when a format was removed from a property.
+var ErrFormatRemoved = errors.New("format removed")
+
+// ErrFormatWidened represents an error state when a format was changed to one that accepts more values.
+var ErrFormatWidened = errors.New("format widened")
+
+// ErrFormatNarrowed represents an error state when a format was changed to one that accepts fewer values.
+var ErrFormatNarrowed = errors.New("format narrowed")
+
+// ErrFormatChanged represents an error state when a format was changed to an unrelated format.
+var ErrFormatChanged = errors.New("format changed")
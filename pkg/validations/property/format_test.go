@@ -0,0 +1,264 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
+)
+
+func TestFormat(t *testing.T) {
+	testcases := []internaltesting.Testcase[apiextensionsv1.JSONSchemaProps]{
+		{
+			Name: "no diff, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Format: "date-time",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Format: "date-time",
+			},
+			Flagged:              false,
+			ComparableValidation: &Format{},
+		},
+		{
+			Name: "format alias, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Format: "date-time",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Format: "datetime",
+			},
+			Flagged:              false,
+			ComparableValidation: &Format{},
+		},
+		{
+			Name: "format added, flagged",
+			Old:  &apiextensionsv1.JSONSchemaProps{},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Format: "date-time",
+			},
+			Flagged:              true,
+			ComparableValidation: &Format{},
+		},
+		{
+			Name: "format added, flagged even when removals and widenings are allowed",
+			Old:  &apiextensionsv1.JSONSchemaProps{},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Format: "byte",
+			},
+			Flagged: true,
+			ComparableValidation: &Format{
+				FormatConfig: FormatConfig{
+					RemovalPolicy:      FormatRemovalPolicyAllow,
+					AllowedTransitions: []FormatTransition{{From: "int32", To: "int64"}},
+				},
+			},
+		},
+		{
+			Name: "format removed, flagged by default",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Format: "byte",
+			},
+			New:                  &apiextensionsv1.JSONSchemaProps{},
+			Flagged:              true,
+			ComparableValidation: &Format{},
+		},
+		{
+			Name: "format removed, allowed via config",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Format: "byte",
+			},
+			New:     &apiextensionsv1.JSONSchemaProps{},
+			Flagged: false,
+			ComparableValidation: &Format{
+				FormatConfig: FormatConfig{RemovalPolicy: FormatRemovalPolicyAllow},
+			},
+		},
+		{
+			Name: "format widened, flagged by default",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Format: "int32",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Format: "int64",
+			},
+			Flagged:              true,
+			ComparableValidation: &Format{},
+		},
+		{
+			Name: "format widened, allowed via config",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Format: "uuid4",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Format: "uuid",
+			},
+			Flagged: false,
+			ComparableValidation: &Format{
+				FormatConfig: FormatConfig{AllowedTransitions: []FormatTransition{{From: "uuid4", To: "uuid"}}},
+			},
+		},
+		{
+			Name: "format widened, other transition allowed via config, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Format: "uuid4",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Format: "uuid",
+			},
+			Flagged: true,
+			ComparableValidation: &Format{
+				FormatConfig: FormatConfig{AllowedTransitions: []FormatTransition{{From: "uuid3", To: "uuid"}}},
+			},
+		},
+		{
+			Name: "format narrowed, flagged when only the widening is allowed",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Format: "int64",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Format: "int32",
+			},
+			Flagged: true,
+			ComparableValidation: &Format{
+				FormatConfig: FormatConfig{AllowedTransitions: []FormatTransition{{From: "int32", To: "int64"}}},
+			},
+		},
+		{
+			Name: "format changed to unrelated format, flagged when only a widening is allowed",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Format: "email",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Format: "hostname",
+			},
+			Flagged: true,
+			ComparableValidation: &Format{
+				FormatConfig: FormatConfig{AllowedTransitions: []FormatTransition{{From: "int32", To: "int64"}}},
+			},
+		},
+		{
+			Name: "different field changed, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				ID: "foo",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				ID: "bar",
+			},
+			Flagged:              false,
+			ComparableValidation: &Format{},
+		},
+	}
+
+	internaltesting.RunTestcases(t, testcases...)
+}
+
+func TestFormatWidens(t *testing.T) {
+	testcases := []struct {
+		from string
+		to   string
+		want bool
+	}{
+		{from: "int32", to: "int64", want: true},
+		{from: "int64", to: "int32", want: false},
+		{from: "float", to: "double", want: true},
+		{from: "double", to: "float", want: false},
+		{from: "uuid3", to: "uuid", want: true},
+		{from: "uuid", to: "uuid5", want: false},
+		{from: "isbn13", to: "isbn", want: true},
+		{from: "isbn10", to: "isbn13", want: false},
+		{from: "email", to: "hostname", want: false},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.from+"->"+tc.to, func(t *testing.T) {
+			if got := formatWidens(tc.from, tc.to); got != tc.want {
+				t.Fatalf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateFormatConfig(t *testing.T) {
+	testcases := []struct {
+		name              string
+		cfg               *FormatConfig
+		wantErr           error
+		wantRemovalPolicy FormatRemovalPolicy
+	}{
+		{
+			name: "nil config",
+			cfg:  nil,
+		},
+		{
+			name:              "defaults policies",
+			cfg:               &FormatConfig{},
+			wantRemovalPolicy: FormatRemovalPolicyDisallow,
+		},
+		{
+			name:              "allows valid policies and transitions",
+			cfg:               &FormatConfig{RemovalPolicy: FormatRemovalPolicyAllow, AllowedTransitions: []FormatTransition{{From: "int32", To: "int64"}}},
+			wantRemovalPolicy: FormatRemovalPolicyAllow,
+		},
+		{
+			name:    "invalid removal policy",
+			cfg:     &FormatConfig{RemovalPolicy: "invalid"},
+			wantErr: errUnknownFormatRemovalPolicy,
+		},
+		{
+			name:    "transition without a to format",
+			cfg:     &FormatConfig{AllowedTransitions: []FormatTransition{{From: "int32"}}},
+			wantErr: errEmptyFormatTransition,
+		},
+		{
+			name:    "narrowing transition",
+			cfg:     &FormatConfig{AllowedTransitions: []FormatTransition{{From: "int64", To: "int32"}}},
+			wantErr: errFormatTransitionNotWidening,
+		},
+		{
+			name:    "transition to an unrelated format",
+			cfg:     &FormatConfig{AllowedTransitions: []FormatTransition{{From: "date", To: "date-time"}}},
+			wantErr: errFormatTransitionNotWidening,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateFormatConfig(tc.cfg)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tc.cfg == nil {
+				return
+			}
+
+			if tc.cfg.RemovalPolicy != tc.wantRemovalPolicy {
+				t.Fatalf("expected removal policy %q, got %q", tc.wantRemovalPolicy, tc.cfg.RemovalPolicy)
+			}
+		})
+	}
+}
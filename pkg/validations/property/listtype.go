@@ -0,0 +1,191 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+)
+
+var (
+	_ validations.Validation                                  = (*ListType)(nil)
+	_ validations.Comparator[apiextensionsv1.JSONSchemaProps] = (*ListType)(nil)
+)
+
+const listTypeValidationName = "listType"
+
+const (
+	listTypeAtomic = "atomic"
+	listTypeSet    = "set"
+	listTypeMap    = "map"
+)
+
+// RegisterListType registers the ListType validation
+// with the provided validation registry.
+func RegisterListType(registry validations.Registry) {
+	registry.Register(listTypeValidationName, listTypeFactory)
+}
+
+// listTypeFactory is a function used to initialize a ListType validation
+// implementation based on the provided configuration.
+func listTypeFactory(_ map[string]interface{}) (validations.Validation, error) {
+	return &ListType{}, nil
+}
+
+// ListType is a Validation that can be used to identify
+// changes to the x-kubernetes-list-type and x-kubernetes-list-map-keys
+// of CRD properties that change how server-side apply merges lists.
+type ListType struct {
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the ListType validation.
+func (lt *ListType) Name() string {
+	return listTypeValidationName
+}
+
+// SetEnforcement sets the EnforcementPolicy for the ListType validation.
+func (lt *ListType) SetEnforcement(policy config.EnforcementPolicy) {
+	lt.enforcement = policy
+}
+
+// Compare compares an old and a new JSONSchemaProps, checking for changes to the list type and list map keys of a property.
+// Changes are only evaluated when both the old and new property are lists, meaning they have an items schema.
+// The items schema is also used to check whether list map keys are still required.
+// In order for callers to determine if diffs to a JSONSchemaProps have been handled by this validation
+// the JSONSchemaProps.XListType and JSONSchemaProps.XListMapKeys fields will be reset to 'nil' as part of this method.
+// It is highly recommended that only copies of the JSONSchemaProps to compare are provided to this method
+// to prevent unintentional modifications.
+func (lt *ListType) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.ComparisonResult {
+	errs := []error{}
+
+	if a.Items != nil && b.Items != nil {
+		errs = append(errs, compareListTypes(a, b)...)
+	}
+
+	a.XListType = nil
+	b.XListType = nil
+	a.XListMapKeys = nil
+	b.XListMapKeys = nil
+
+	return validations.HandleErrors(lt.Name(), lt.enforcement, errs...)
+}
+
+// compareListTypes compares the list type and list map keys of two array properties
+// and returns an error, explaining the impact on server-side apply, for each change.
+func compareListTypes(a, b *apiextensionsv1.JSONSchemaProps) []error {
+	oldListType := listTypeOf(a)
+	newListType := listTypeOf(b)
+
+	if oldListType != newListType {
+		return []error{fmt.Errorf("%w : %q -> %q : %s", ErrListTypeChanged, oldListType, newListType, listTypeChangeImpact(oldListType, newListType, b.XListMapKeys))}
+	}
+
+	if newListType != listTypeMap {
+		return nil
+	}
+
+	errs := []error{}
+
+	oldKeys := sets.New(a.XListMapKeys...)
+	newKeys := sets.New(b.XListMapKeys...)
+
+	if added := sets.List(newKeys.Difference(oldKeys)); len(added) > 0 {
+		errs = append(errs, fmt.Errorf("%w : %v -> %v : server-side apply now identifies list items by %v, so existing items are re-keyed and appliers that do not set %v can no longer update their items",
+			ErrListMapKeysAdded, a.XListMapKeys, b.XListMapKeys, b.XListMapKeys, added))
+	}
+
+	if removed := sets.List(oldKeys.Difference(newKeys)); len(removed) > 0 {
+		errs = append(errs, fmt.Errorf("%w : %v -> %v : server-side apply now identifies list items by %v, so existing items that only differ by %v collide and are merged",
+			ErrListMapKeysRemoved, a.XListMapKeys, b.XListMapKeys, b.XListMapKeys, removed))
+	}
+
+	for _, key := range sets.List(oldKeys.Intersection(newKeys)) {
+		if listMapKeyRequired(a, key) && !listMapKeyRequired(b, key) {
+			errs = append(errs, fmt.Errorf("%w : %q : server-side apply can not identify list items that do not set %q and the API server rejects list map keys that are neither required nor defaulted",
+				ErrListMapKeyNotRequired, key, key))
+		}
+	}
+
+	return errs
+}
+
+// listTypeOf returns the list type of an array property.
+// Arrays without an explicit list type are treated as atomic
+// lists, in the same way that server-side apply treats them.
+func listTypeOf(s *apiextensionsv1.JSONSchemaProps) string {
+	listType := ptr.Deref(s.XListType, "")
+	if listType == "" {
+		return listTypeAtomic
+	}
+
+	return listType
+}
+
+// listTypeChangeImpact explains how changing the list type
+// from oldListType to newListType changes how server-side apply
+// merges the list.
+func listTypeChangeImpact(oldListType, newListType string, newKeys []string) string {
+	switch {
+	case newListType == listTypeAtomic:
+		return "server-side apply now replaces the whole list, so the ownership of individual items is lost and appliers overwrite items owned by other field managers"
+	case oldListType == listTypeAtomic && newListType == listTypeMap:
+		return fmt.Sprintf("server-side apply now merges list items by %v, so appliers that relied on replacing the whole list no longer remove the items they omit", newKeys)
+	case oldListType == listTypeAtomic && newListType == listTypeSet:
+		return "server-side apply now merges list items by value, so appliers that relied on replacing the whole list no longer remove the items they omit and duplicate items are rejected"
+	case newListType == listTypeMap:
+		return fmt.Sprintf("server-side apply now identifies list items by %v instead of by value, so the ownership of existing items is reassigned", newKeys)
+	case newListType == listTypeSet:
+		return "server-side apply now identifies list items by value instead of by map keys, so the ownership of existing items is reassigned and duplicate items are rejected"
+	default:
+		return "server-side apply merge behavior changed"
+	}
+}
+
+// listMapKeyRequired returns whether the list map key
+// is required, or has a default, in the items schema of s.
+// The API server fills in defaulted keys so they are always set.
+func listMapKeyRequired(s *apiextensionsv1.JSONSchemaProps, key string) bool {
+	if s.Items == nil || s.Items.Schema == nil {
+		return false
+	}
+
+	if slices.Contains(s.Items.Schema.Required, key) {
+		return true
+	}
+
+	keySchema, ok := s.Items.Schema.Properties[key]
+
+	return ok && keySchema.Default != nil
+}
+
+// ErrListTypeChanged represents an error state when the list type of a property changed.
+var ErrListTypeChanged = errors.New("list type changed")
+
+// ErrListMapKeysAdded represents an error state when list map keys were added to a property.
+var ErrListMapKeysAdded = errors.New("list map keys added")
+
+// ErrListMapKeysRemoved represents an error state when list map keys were removed from a property.
+var ErrListMapKeysRemoved = errors.New("list map keys removed")
+
+// ErrListMapKeyNotRequired represents an error state when a list map key is no longer required or defaulted.
+var ErrListMapKeyNotRequired = errors.New("list map key no longer required")
@@ -0,0 +1,353 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"strings"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/crdify/pkg/config"
+	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
+)
+
+func TestListType(t *testing.T) {
+	testcases := []internaltesting.Testcase[apiextensionsv1.JSONSchemaProps]{
+		{
+			Name: "no diff, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:         "array",
+				XListType:    ptr.To(listTypeMap),
+				XListMapKeys: []string{"name"},
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+					Schema: &apiextensionsv1.JSONSchemaProps{
+						Type:     "object",
+						Required: []string{"name"},
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"name":     {Type: "string"},
+							"protocol": {Type: "string"},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:         "array",
+				XListType:    ptr.To(listTypeMap),
+				XListMapKeys: []string{"name"},
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+					Schema: &apiextensionsv1.JSONSchemaProps{
+						Type:     "object",
+						Required: []string{"name"},
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"name":     {Type: "string"},
+							"protocol": {Type: "string"},
+						},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &ListType{},
+		},
+		{
+			Name: "unset to atomic, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:  "array",
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:      "array",
+				XListType: ptr.To(listTypeAtomic),
+				Items:     &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"}},
+			},
+			Flagged:              false,
+			ComparableValidation: &ListType{},
+		},
+		{
+			Name: "atomic to set, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:  "array",
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:      "array",
+				XListType: ptr.To(listTypeSet),
+				Items:     &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"}},
+			},
+			Flagged:              true,
+			ComparableValidation: &ListType{},
+		},
+		{
+			Name: "map to atomic, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:         "array",
+				XListType:    ptr.To(listTypeMap),
+				XListMapKeys: []string{"name"},
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+					Schema: &apiextensionsv1.JSONSchemaProps{
+						Type:     "object",
+						Required: []string{"name"},
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"name":     {Type: "string"},
+							"protocol": {Type: "string"},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:      "array",
+				XListType: ptr.To(listTypeAtomic),
+				Items:     &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"}},
+			},
+			Flagged:              true,
+			ComparableValidation: &ListType{},
+		},
+		{
+			Name: "map key added, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:         "array",
+				XListType:    ptr.To(listTypeMap),
+				XListMapKeys: []string{"name"},
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+					Schema: &apiextensionsv1.JSONSchemaProps{
+						Type:     "object",
+						Required: []string{"name", "protocol"},
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"name":     {Type: "string"},
+							"protocol": {Type: "string"},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:         "array",
+				XListType:    ptr.To(listTypeMap),
+				XListMapKeys: []string{"name", "protocol"},
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+					Schema: &apiextensionsv1.JSONSchemaProps{
+						Type:     "object",
+						Required: []string{"name", "protocol"},
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"name":     {Type: "string"},
+							"protocol": {Type: "string"},
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &ListType{},
+		},
+		{
+			Name: "map key removed, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:         "array",
+				XListType:    ptr.To(listTypeMap),
+				XListMapKeys: []string{"name", "protocol"},
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+					Schema: &apiextensionsv1.JSONSchemaProps{
+						Type:     "object",
+						Required: []string{"name", "protocol"},
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"name":     {Type: "string"},
+							"protocol": {Type: "string"},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:         "array",
+				XListType:    ptr.To(listTypeMap),
+				XListMapKeys: []string{"name"},
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+					Schema: &apiextensionsv1.JSONSchemaProps{
+						Type:     "object",
+						Required: []string{"name", "protocol"},
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"name":     {Type: "string"},
+							"protocol": {Type: "string"},
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &ListType{},
+		},
+		{
+			Name: "map keys reordered, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:         "array",
+				XListType:    ptr.To(listTypeMap),
+				XListMapKeys: []string{"name", "protocol"},
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+					Schema: &apiextensionsv1.JSONSchemaProps{
+						Type:     "object",
+						Required: []string{"name", "protocol"},
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"name":     {Type: "string"},
+							"protocol": {Type: "string"},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:         "array",
+				XListType:    ptr.To(listTypeMap),
+				XListMapKeys: []string{"protocol", "name"},
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+					Schema: &apiextensionsv1.JSONSchemaProps{
+						Type:     "object",
+						Required: []string{"name", "protocol"},
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"name":     {Type: "string"},
+							"protocol": {Type: "string"},
+						},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &ListType{},
+		},
+		{
+			Name: "map key no longer required, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:         "array",
+				XListType:    ptr.To(listTypeMap),
+				XListMapKeys: []string{"name"},
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+					Schema: &apiextensionsv1.JSONSchemaProps{
+						Type:     "object",
+						Required: []string{"name"},
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"name":     {Type: "string"},
+							"protocol": {Type: "string"},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:         "array",
+				XListType:    ptr.To(listTypeMap),
+				XListMapKeys: []string{"name"},
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+					Schema: &apiextensionsv1.JSONSchemaProps{
+						Type: "object",
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"name":     {Type: "string"},
+							"protocol": {Type: "string"},
+						},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &ListType{},
+		},
+		{
+			Name: "map key no longer required but defaulted, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:         "array",
+				XListType:    ptr.To(listTypeMap),
+				XListMapKeys: []string{"name"},
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+					Schema: &apiextensionsv1.JSONSchemaProps{
+						Type:     "object",
+						Required: []string{"name"},
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"name":     {Type: "string"},
+							"protocol": {Type: "string"},
+						},
+					},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:         "array",
+				XListType:    ptr.To(listTypeMap),
+				XListMapKeys: []string{"name"},
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+					Schema: &apiextensionsv1.JSONSchemaProps{
+						Type: "object",
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"name": {
+								Type:    "string",
+								Default: &apiextensionsv1.JSON{Raw: []byte(`"default"`)},
+							},
+							"protocol": {Type: "string"},
+						},
+					},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &ListType{},
+		},
+		{
+			Name: "not an array, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:      "array",
+				XListType: ptr.To(listTypeSet),
+				Items:     &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"}},
+			},
+			New:                  &apiextensionsv1.JSONSchemaProps{Type: "string"},
+			Flagged:              false,
+			ComparableValidation: &ListType{},
+		},
+		{
+			Name: "different field changed, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				ID: "foo",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				ID: "bar",
+			},
+			Flagged:              false,
+			ComparableValidation: &ListType{},
+		},
+	}
+
+	internaltesting.RunTestcases(t, testcases...)
+}
+
+func TestListTypeExplainsSSAImpact(t *testing.T) {
+	val := &ListType{}
+	val.SetEnforcement(config.EnforcementPolicyError)
+
+	result := val.Compare(
+		&apiextensionsv1.JSONSchemaProps{
+			Type:  "array",
+			Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"}},
+		},
+		&apiextensionsv1.JSONSchemaProps{
+			Type:         "array",
+			XListType:    ptr.To(listTypeMap),
+			XListMapKeys: []string{"name"},
+			Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+				Schema: &apiextensionsv1.JSONSchemaProps{
+					Type:     "object",
+					Required: []string{"name"},
+					Properties: map[string]apiextensionsv1.JSONSchemaProps{
+						"name":     {Type: "string"},
+						"protocol": {Type: "string"},
+					},
+				},
+			},
+		},
+	)
+
+	if len(result.Errors) != 1 {
+		t.Fatalf("expected a single error, got %v", result.Errors)
+	}
+
+	if !strings.Contains(result.Errors[0], "server-side apply now merges list items by [name]") {
+		t.Fatalf("expected error to explain the server-side apply impact, got %q", result.Errors[0])
+	}
+}
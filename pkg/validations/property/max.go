@@ -54,6 +54,8 @@ var (
 	ErrNetNewMaximumConstraint = errors.New("maximum constraint added when there was none previously")
 	// ErrMaximumIncreased represents an error state where a maximum constaint on a property was decreased.
 	ErrMaximumIncreased = errors.New("maximum decreased")
+	// ErrMaximumMadeExclusive represents an error state where an unchanged maximum constraint on a property was made exclusive.
+	ErrMaximumMadeExclusive = errors.New("maximum made exclusive")
 )
 
 var (
@@ -92,15 +94,24 @@ func (m *Maximum) SetEnforcement(policy config.EnforcementPolicy) {
 }
 
 // Compare compares an old and a new JSONSchemaProps, checking for incompatible changes to the maximum constraints of a property.
+// The ExclusiveMaximum field is evaluated together with the Maximum field, so making an unchanged maximum exclusive is
+// considered a tightening of the constraint.
 // In order for callers to determine if diffs to a JSONSchemaProps have been handled by this validation
-// the JSONSchemaProps.Maximum field will be reset to 'nil' as part of this method.
+// the JSONSchemaProps.Maximum field will be reset to 'nil' and the JSONSchemaProps.ExclusiveMaximum field will be
+// reset to 'false' as part of this method.
 // It is highly recommended that only copies of the JSONSchemaProps to compare are provided to this method
 // to prevent unintentional modifications.
 func (m *Maximum) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.ComparisonResult {
 	err := MaxVerification(a.Maximum, b.Maximum)
 
+	if err == nil && a.Maximum != nil && b.Maximum != nil && *a.Maximum == *b.Maximum && !a.ExclusiveMaximum && b.ExclusiveMaximum {
+		err = fmt.Errorf("%w : %v -> %v (exclusive)", ErrMaximumMadeExclusive, *a.Maximum, *b.Maximum)
+	}
+
 	a.Maximum = nil
 	b.Maximum = nil
+	a.ExclusiveMaximum = false
+	b.ExclusiveMaximum = false
 
 	return validations.HandleErrors(m.Name(), m.enforcement, err)
 }
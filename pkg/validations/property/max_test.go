@@ -66,6 +66,55 @@ func TestMaximum(t *testing.T) {
 			Flagged:              false,
 			ComparableValidation: &Maximum{},
 		},
+		{
+			Name: "maximum made exclusive, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Maximum: ptr.To(10.0),
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Maximum:          ptr.To(10.0),
+				ExclusiveMaximum: true,
+			},
+			Flagged:              true,
+			ComparableValidation: &Maximum{},
+		},
+		{
+			Name: "maximum made inclusive, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Maximum:          ptr.To(10.0),
+				ExclusiveMaximum: true,
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Maximum: ptr.To(10.0),
+			},
+			Flagged:              false,
+			ComparableValidation: &Maximum{},
+		},
+		{
+			Name: "maximum loosened and made exclusive, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Maximum: ptr.To(10.0),
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Maximum:          ptr.To(20.0),
+				ExclusiveMaximum: true,
+			},
+			Flagged:              false,
+			ComparableValidation: &Maximum{},
+		},
+		{
+			Name: "exclusive maximum decreased, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Maximum:          ptr.To(20.0),
+				ExclusiveMaximum: true,
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Maximum:          ptr.To(10.0),
+				ExclusiveMaximum: true,
+			},
+			Flagged:              true,
+			ComparableValidation: &Maximum{},
+		},
 		{
 			Name: "different field changed, not flagged",
 			Old: &apiextensionsv1.JSONSchemaProps{
@@ -54,6 +54,8 @@ var (
 	ErrNetNewMinimumConstraint = errors.New("minimum constraint added when there was none previously")
 	// ErrMinimumIncreased represents an error state where a minimum constaint on a property was increased.
 	ErrMinimumIncreased = errors.New("minimum increased")
+	// ErrMinimumMadeExclusive represents an error state where an unchanged minimum constraint on a property was made exclusive.
+	ErrMinimumMadeExclusive = errors.New("minimum made exclusive")
 )
 
 var (
@@ -92,15 +94,24 @@ func (m *Minimum) SetEnforcement(policy config.EnforcementPolicy) {
 }
 
 // Compare compares an old and a new JSONSchemaProps, checking for incompatible changes to the minimum constraints of a property.
+// The ExclusiveMinimum field is evaluated together with the Minimum field, so making an unchanged minimum exclusive is
+// considered a tightening of the constraint.
 // In order for callers to determine if diffs to a JSONSchemaProps have been handled by this validation
-// the JSONSchemaProps.Minimum field will be reset to 'nil' as part of this method.
+// the JSONSchemaProps.Minimum field will be reset to 'nil' and the JSONSchemaProps.ExclusiveMinimum field will be
+// reset to 'false' as part of this method.
 // It is highly recommended that only copies of the JSONSchemaProps to compare are provided to this method
 // to prevent unintentional modifications.
 func (m *Minimum) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.ComparisonResult {
 	err := MinVerification(a.Minimum, b.Minimum)
 
+	if err == nil && a.Minimum != nil && b.Minimum != nil && *a.Minimum == *b.Minimum && !a.ExclusiveMinimum && b.ExclusiveMinimum {
+		err = fmt.Errorf("%w : %v -> %v (exclusive)", ErrMinimumMadeExclusive, *a.Minimum, *b.Minimum)
+	}
+
 	a.Minimum = nil
 	b.Minimum = nil
+	a.ExclusiveMinimum = false
+	b.ExclusiveMinimum = false
 
 	return validations.HandleErrors(m.Name(), m.enforcement, err)
 }
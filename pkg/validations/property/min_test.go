@@ -66,6 +66,55 @@ func TestMinimum(t *testing.T) {
 			Flagged:              true,
 			ComparableValidation: &Minimum{},
 		},
+		{
+			Name: "minimum made exclusive, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Minimum: ptr.To(10.0),
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Minimum:          ptr.To(10.0),
+				ExclusiveMinimum: true,
+			},
+			Flagged:              true,
+			ComparableValidation: &Minimum{},
+		},
+		{
+			Name: "minimum made inclusive, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Minimum:          ptr.To(10.0),
+				ExclusiveMinimum: true,
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Minimum: ptr.To(10.0),
+			},
+			Flagged:              false,
+			ComparableValidation: &Minimum{},
+		},
+		{
+			Name: "minimum loosened and made exclusive, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Minimum: ptr.To(10.0),
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Minimum:          ptr.To(5.0),
+				ExclusiveMinimum: true,
+			},
+			Flagged:              false,
+			ComparableValidation: &Minimum{},
+		},
+		{
+			Name: "exclusive minimum increased, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Minimum:          ptr.To(5.0),
+				ExclusiveMinimum: true,
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Minimum:          ptr.To(10.0),
+				ExclusiveMinimum: true,
+			},
+			Flagged:              true,
+			ComparableValidation: &Minimum{},
+		},
 		{
 			Name: "different field changed, not flagged",
 			Old: &apiextensionsv1.JSONSchemaProps{
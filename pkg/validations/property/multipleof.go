@@ -0,0 +1,107 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+)
+
+var (
+	_ validations.Validation                                  = (*MultipleOf)(nil)
+	_ validations.Comparator[apiextensionsv1.JSONSchemaProps] = (*MultipleOf)(nil)
+)
+
+const multipleOfValidationName = "multipleOf"
+
+// RegisterMultipleOf registers the MultipleOf validation
+// with the provided validation registry.
+func RegisterMultipleOf(registry validations.Registry) {
+	registry.Register(multipleOfValidationName, multipleOfFactory)
+}
+
+// multipleOfFactory is a function used to initialize a MultipleOf validation
+// implementation based on the provided configuration.
+func multipleOfFactory(_ map[string]interface{}) (validations.Validation, error) {
+	return &MultipleOf{}, nil
+}
+
+// MultipleOf is a Validation that can be used to identify
+// incompatible changes to the multipleOf constraints of CRD properties.
+type MultipleOf struct {
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the MultipleOf validation.
+func (m *MultipleOf) Name() string {
+	return multipleOfValidationName
+}
+
+// SetEnforcement sets the EnforcementPolicy for the MultipleOf validation.
+func (m *MultipleOf) SetEnforcement(policy config.EnforcementPolicy) {
+	m.enforcement = policy
+}
+
+// Compare compares an old and a new JSONSchemaProps, checking for incompatible changes to the multipleOf constraints of a property.
+// Changing the multipleOf constraint is only compatible when every value that was previously accepted is still accepted,
+// which is the case when the old multipleOf is itself a multiple of the new multipleOf (i.e 4 -> 2).
+// In order for callers to determine if diffs to a JSONSchemaProps have been handled by this validation
+// the JSONSchemaProps.MultipleOf field will be reset to 'nil' as part of this method.
+// It is highly recommended that only copies of the JSONSchemaProps to compare are provided to this method
+// to prevent unintentional modifications.
+func (m *MultipleOf) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.ComparisonResult {
+	var err error
+
+	switch {
+	case a.MultipleOf == nil && b.MultipleOf != nil:
+		err = fmt.Errorf("%w : %v", ErrNetNewMultipleOfConstraint, *b.MultipleOf)
+	case a.MultipleOf != nil && b.MultipleOf != nil && !isMultipleOf(*a.MultipleOf, *b.MultipleOf):
+		err = fmt.Errorf("%w : %v -> %v", ErrMultipleOfTightened, *a.MultipleOf, *b.MultipleOf)
+	}
+
+	a.MultipleOf = nil
+	b.MultipleOf = nil
+
+	return validations.HandleErrors(m.Name(), m.enforcement, err)
+}
+
+// multipleOfTolerance is the tolerance used when
+// determining if a float64 is a whole number to
+// account for floating point precision errors.
+const multipleOfTolerance = 1e-9
+
+// isMultipleOf returns whether value is a whole number multiple of factor.
+func isMultipleOf(value, factor float64) bool {
+	if factor == 0 {
+		return false
+	}
+
+	quotient := value / factor
+
+	return math.Abs(quotient-math.Round(quotient)) < multipleOfTolerance
+}
+
+var (
+	// ErrNetNewMultipleOfConstraint represents an error state where a net new multipleOf constraint was added to a property.
+	ErrNetNewMultipleOfConstraint = errors.New("multipleOf constraint added when there was none previously")
+	// ErrMultipleOfTightened represents an error state where a multipleOf constraint on a property was changed
+	// to a value that no longer accepts all of the previously accepted values.
+	ErrMultipleOfTightened = errors.New("multipleOf tightened")
+)
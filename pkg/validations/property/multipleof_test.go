@@ -0,0 +1,114 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
+)
+
+func TestMultipleOf(t *testing.T) {
+	testcases := []internaltesting.Testcase[apiextensionsv1.JSONSchemaProps]{
+		{
+			Name: "no diff, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				MultipleOf: ptr.To(2.0),
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				MultipleOf: ptr.To(2.0),
+			},
+			Flagged:              false,
+			ComparableValidation: &MultipleOf{},
+		},
+		{
+			Name: "new multipleOf constraint, flagged",
+			Old:  &apiextensionsv1.JSONSchemaProps{},
+			New: &apiextensionsv1.JSONSchemaProps{
+				MultipleOf: ptr.To(2.0),
+			},
+			Flagged:              true,
+			ComparableValidation: &MultipleOf{},
+		},
+		{
+			Name: "multipleOf constraint removed, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				MultipleOf: ptr.To(2.0),
+			},
+			New:                  &apiextensionsv1.JSONSchemaProps{},
+			Flagged:              false,
+			ComparableValidation: &MultipleOf{},
+		},
+		{
+			Name: "multipleOf tightened from 2 to 4, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				MultipleOf: ptr.To(2.0),
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				MultipleOf: ptr.To(4.0),
+			},
+			Flagged:              true,
+			ComparableValidation: &MultipleOf{},
+		},
+		{
+			Name: "multipleOf loosened from 4 to 2, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				MultipleOf: ptr.To(4.0),
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				MultipleOf: ptr.To(2.0),
+			},
+			Flagged:              false,
+			ComparableValidation: &MultipleOf{},
+		},
+		{
+			Name: "multipleOf changed to unrelated value, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				MultipleOf: ptr.To(2.0),
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				MultipleOf: ptr.To(3.0),
+			},
+			Flagged:              true,
+			ComparableValidation: &MultipleOf{},
+		},
+		{
+			Name: "fractional multipleOf loosened, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				MultipleOf: ptr.To(0.3),
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				MultipleOf: ptr.To(0.1),
+			},
+			Flagged:              false,
+			ComparableValidation: &MultipleOf{},
+		},
+		{
+			Name: "different field changed, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				ID: "foo",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				ID: "bar",
+			},
+			Flagged:              false,
+			ComparableValidation: &MultipleOf{},
+		},
+	}
+
+	internaltesting.RunTestcases(t, testcases...)
+}
@@ -69,10 +69,22 @@ func ValidatePatternConfig(in *PatternConfig) error {
 		return fmt.Errorf("%w : %q (valid values: %q, %q)", errUnknownPatternRemovalPolicy, in.RemovalPolicy, PatternRemovalPolicyAllow, PatternRemovalPolicyDisallow)
 	}
 
+	switch in.AnalysisPolicy {
+	case PatternAnalysisPolicySubset, PatternAnalysisPolicyNone:
+		// valid entries
+	case PatternAnalysisPolicy(""):
+		in.AnalysisPolicy = PatternAnalysisPolicySubset
+	default:
+		return fmt.Errorf("%w : %q (valid values: %q, %q)", errUnknownPatternAnalysisPolicy, in.AnalysisPolicy, PatternAnalysisPolicySubset, PatternAnalysisPolicyNone)
+	}
+
 	return nil
 }
 
-var errUnknownPatternRemovalPolicy = errors.New("unknown removal policy")
+var (
+	errUnknownPatternRemovalPolicy  = errors.New("unknown removal policy")
+	errUnknownPatternAnalysisPolicy = errors.New("unknown analysis policy")
+)
 
 // PatternRemovalPolicy represents how removing a pattern constraint should be evaluated.
 type PatternRemovalPolicy string
@@ -84,11 +96,27 @@ const (
 	PatternRemovalPolicyDisallow PatternRemovalPolicy = "Disallow"
 )
 
+// PatternAnalysisPolicy represents how changing a pattern should be analyzed.
+type PatternAnalysisPolicy string
+
+const (
+	// PatternAnalysisPolicySubset compares the strings accepted by the old and new pattern.
+	// Changing a pattern to one that accepts every string the old pattern accepted is compatible.
+	// Changes that can not be analyzed are treated as incompatible.
+	PatternAnalysisPolicySubset PatternAnalysisPolicy = "Subset"
+	// PatternAnalysisPolicyNone treats any change to a pattern as incompatible.
+	PatternAnalysisPolicyNone PatternAnalysisPolicy = "None"
+)
+
 // PatternConfig contains additional configuration for the Pattern validation.
 type PatternConfig struct {
 	// RemovalPolicy dictates whether removing a pattern constraint is compatible.
 	// Allowed values are Allow and Disallow. Defaults to Disallow.
 	RemovalPolicy PatternRemovalPolicy `json:"removalPolicy,omitempty"`
+
+	// AnalysisPolicy dictates how changes to a pattern are analyzed.
+	// Allowed values are Subset and None. Defaults to Subset.
+	AnalysisPolicy PatternAnalysisPolicy `json:"analysisPolicy,omitempty"`
 }
 
 // Pattern is a Validation that can be used to identify
@@ -124,7 +152,7 @@ func (p *Pattern) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.Com
 	case a.Pattern != "" && b.Pattern == "" && p.RemovalPolicy != PatternRemovalPolicyAllow:
 		err = fmt.Errorf("%w : %q -> %q", ErrPatternRemoved, a.Pattern, b.Pattern)
 	case b.Pattern != "" && a.Pattern != b.Pattern:
-		err = fmt.Errorf("%w : %q -> %q", ErrPatternChanged, a.Pattern, b.Pattern)
+		err = p.comparePatterns(a.Pattern, b.Pattern)
 	}
 
 	a.Pattern = ""
@@ -133,11 +161,34 @@ func (p *Pattern) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.Com
 	return validations.HandleErrors(p.Name(), p.enforcement, err)
 }
 
+// comparePatterns compares two different patterns, returning an error when the new pattern
+// does not accept every string accepted by the old pattern, or when that can not be determined.
+func (p *Pattern) comparePatterns(oldPattern, newPattern string) error {
+	if p.AnalysisPolicy == PatternAnalysisPolicyNone {
+		return fmt.Errorf("%w : %q -> %q", ErrPatternChanged, oldPattern, newPattern)
+	}
+
+	superset, example, err := patternAcceptsSuperset(oldPattern, newPattern)
+
+	switch {
+	case err != nil:
+		return fmt.Errorf("%w : %q -> %q : unable to determine whether the change is compatible: %w", ErrPatternChanged, oldPattern, newPattern, err)
+	case !superset:
+		return fmt.Errorf("%w : %q -> %q : %q was accepted but is now rejected", ErrPatternNarrowed, oldPattern, newPattern, example)
+	default:
+		return nil
+	}
+}
+
 // ErrPatternAdded represents an error state when a property Pattern was added.
 var ErrPatternAdded = errors.New("pattern added")
 
 // ErrPatternChanged represents an error state when a property Pattern changed.
 var ErrPatternChanged = errors.New("pattern changed")
 
+// ErrPatternNarrowed represents an error state when a property Pattern changed
+// to one that rejects values accepted by the previous Pattern.
+var ErrPatternNarrowed = errors.New("pattern narrowed")
+
 // ErrPatternRemoved represents an error state when a property Pattern was removed.
 var ErrPatternRemoved = errors.New("pattern removed")
@@ -55,6 +55,74 @@ func TestPattern(t *testing.T) {
 			Flagged:              true,
 			ComparableValidation: &Pattern{},
 		},
+		{
+			Name: "pattern widened, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Pattern: "^[a-z]+$",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Pattern: "^[a-z0-9]+$",
+			},
+			Flagged:              false,
+			ComparableValidation: &Pattern{},
+		},
+		{
+			Name: "pattern narrowed, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Pattern: "^[a-z0-9]+$",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Pattern: "^[a-z]+$",
+			},
+			Flagged:              true,
+			ComparableValidation: &Pattern{},
+		},
+		{
+			Name: "pattern anchored, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Pattern: "[a-z]+",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Pattern: "^[a-z]+$",
+			},
+			Flagged:              true,
+			ComparableValidation: &Pattern{},
+		},
+		{
+			Name: "pattern rewritten to an equivalent pattern, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Pattern: "^[a-z]{1,3}$",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Pattern: "^(?:[a-z]|[a-z][a-z]|[a-z][a-z][a-z])$",
+			},
+			Flagged:              false,
+			ComparableValidation: &Pattern{},
+		},
+		{
+			Name: "pattern widened, flagged when analysis is disabled",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Pattern: "^[a-z]+$",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Pattern: "^[a-z0-9]+$",
+			},
+			Flagged: true,
+			ComparableValidation: &Pattern{
+				PatternConfig: PatternConfig{AnalysisPolicy: PatternAnalysisPolicyNone},
+			},
+		},
+		{
+			Name: "pattern with unsupported assertions changed, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Pattern: `\bfoo`,
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Pattern: `\bfoo|bar`,
+			},
+			Flagged:              true,
+			ComparableValidation: &Pattern{},
+		},
 		{
 			Name: "pattern removed, flagged by default",
 			Old: &apiextensionsv1.JSONSchemaProps{
@@ -93,24 +161,38 @@ func TestPattern(t *testing.T) {
 
 func TestValidatePatternConfig(t *testing.T) {
 	testcases := []struct {
-		name              string
-		cfg               *PatternConfig
-		wantErr           error
-		wantRemovalPolicy PatternRemovalPolicy
+		name               string
+		cfg                *PatternConfig
+		wantErr            error
+		wantRemovalPolicy  PatternRemovalPolicy
+		wantAnalysisPolicy PatternAnalysisPolicy
 	}{
 		{
 			name: "nil config",
 			cfg:  nil,
 		},
 		{
-			name:              "defaults removal policy",
-			cfg:               &PatternConfig{},
-			wantRemovalPolicy: PatternRemovalPolicyDisallow,
+			name:               "defaults policies",
+			cfg:                &PatternConfig{},
+			wantRemovalPolicy:  PatternRemovalPolicyDisallow,
+			wantAnalysisPolicy: PatternAnalysisPolicySubset,
+		},
+		{
+			name:               "allows valid removal policy",
+			cfg:                &PatternConfig{RemovalPolicy: PatternRemovalPolicyAllow},
+			wantRemovalPolicy:  PatternRemovalPolicyAllow,
+			wantAnalysisPolicy: PatternAnalysisPolicySubset,
 		},
 		{
-			name:              "allows valid removal policy",
-			cfg:               &PatternConfig{RemovalPolicy: PatternRemovalPolicyAllow},
-			wantRemovalPolicy: PatternRemovalPolicyAllow,
+			name:               "allows valid analysis policy",
+			cfg:                &PatternConfig{AnalysisPolicy: PatternAnalysisPolicyNone},
+			wantRemovalPolicy:  PatternRemovalPolicyDisallow,
+			wantAnalysisPolicy: PatternAnalysisPolicyNone,
+		},
+		{
+			name:    "invalid analysis policy mentions valid values",
+			cfg:     &PatternConfig{AnalysisPolicy: "invalid"},
+			wantErr: errUnknownPatternAnalysisPolicy,
 		},
 		{
 			name:    "invalid removal policy mentions valid values",
@@ -138,6 +220,10 @@ func TestValidatePatternConfig(t *testing.T) {
 			if tc.cfg != nil && tc.cfg.RemovalPolicy != tc.wantRemovalPolicy {
 				t.Fatalf("expected removal policy %q, got %q", tc.wantRemovalPolicy, tc.cfg.RemovalPolicy)
 			}
+
+			if tc.cfg != nil && tc.cfg.AnalysisPolicy != tc.wantAnalysisPolicy {
+				t.Fatalf("expected analysis policy %q, got %q", tc.wantAnalysisPolicy, tc.cfg.AnalysisPolicy)
+			}
 		})
 	}
 }
@@ -0,0 +1,224 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+)
+
+var (
+	_ validations.Validation                                  = (*PatternProperties)(nil)
+	_ validations.Comparator[apiextensionsv1.JSONSchemaProps] = (*PatternProperties)(nil)
+)
+
+const patternPropertiesValidationName = "patternProperties"
+
+// RegisterPatternProperties registers the PatternProperties validation
+// with the provided validation registry.
+func RegisterPatternProperties(registry validations.Registry) {
+	registry.Register(patternPropertiesValidationName, patternPropertiesFactory)
+}
+
+// patternPropertiesFactory is a function used to initialize a PatternProperties validation
+// implementation based on the provided configuration.
+func patternPropertiesFactory(cfg map[string]interface{}) (validations.Validation, error) {
+	patternPropertiesCfg := &PatternPropertiesConfig{}
+
+	err := ConfigToType(cfg, patternPropertiesCfg)
+	if err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	err = ValidatePatternPropertiesConfig(patternPropertiesCfg)
+	if err != nil {
+		return nil, fmt.Errorf("validating patternProperties config: %w", err)
+	}
+
+	return &PatternProperties{PatternPropertiesConfig: *patternPropertiesCfg}, nil
+}
+
+// ValidatePatternPropertiesConfig ensures provided PatternPropertiesConfig is valid and defaults missing values.
+func ValidatePatternPropertiesConfig(in *PatternPropertiesConfig) error {
+	if in == nil {
+		return nil
+	}
+
+	switch in.RemovalPolicy {
+	case PatternPropertiesRemovalPolicyAllow, PatternPropertiesRemovalPolicyDisallow:
+		// valid entries
+	case PatternPropertiesRemovalPolicy(""):
+		in.RemovalPolicy = PatternPropertiesRemovalPolicyDisallow
+	default:
+		return fmt.Errorf("%w : %q (valid values: %q, %q)", errUnknownPatternPropertiesRemovalPolicy, in.RemovalPolicy, PatternPropertiesRemovalPolicyAllow, PatternPropertiesRemovalPolicyDisallow)
+	}
+
+	switch in.AnalysisPolicy {
+	case PatternAnalysisPolicySubset, PatternAnalysisPolicyNone:
+		// valid entries
+	case PatternAnalysisPolicy(""):
+		in.AnalysisPolicy = PatternAnalysisPolicySubset
+	default:
+		return fmt.Errorf("%w : %q (valid values: %q, %q)", errUnknownPatternPropertiesAnalysisPolicy, in.AnalysisPolicy, PatternAnalysisPolicySubset, PatternAnalysisPolicyNone)
+	}
+
+	return nil
+}
+
+var (
+	errUnknownPatternPropertiesRemovalPolicy  = errors.New("unknown removal policy")
+	errUnknownPatternPropertiesAnalysisPolicy = errors.New("unknown analysis policy")
+)
+
+// PatternPropertiesRemovalPolicy represents how no longer validating the values of
+// keys that matched a pattern property should be evaluated.
+type PatternPropertiesRemovalPolicy string
+
+const (
+	// PatternPropertiesRemovalPolicyAllow treats no longer validating the values of keys that matched a pattern property as compatible.
+	PatternPropertiesRemovalPolicyAllow PatternPropertiesRemovalPolicy = "Allow"
+	// PatternPropertiesRemovalPolicyDisallow treats no longer validating the values of keys that matched a pattern property as incompatible.
+	PatternPropertiesRemovalPolicyDisallow PatternPropertiesRemovalPolicy = "Disallow"
+)
+
+// PatternPropertiesConfig contains additional configuration for the PatternProperties validation.
+type PatternPropertiesConfig struct {
+	// RemovalPolicy dictates whether removing a pattern property, or changing its pattern so that
+	// it no longer matches keys it previously matched, is compatible.
+	// Allowed values are Allow and Disallow. Defaults to Disallow.
+	RemovalPolicy PatternPropertiesRemovalPolicy `json:"removalPolicy,omitempty"`
+
+	// AnalysisPolicy dictates how changes to the pattern of a pattern property are analyzed.
+	// Allowed values are Subset and None. Defaults to Subset.
+	AnalysisPolicy PatternAnalysisPolicy `json:"analysisPolicy,omitempty"`
+}
+
+// PatternProperties is a Validation that can be used to identify
+// incompatible changes to the pattern properties of CRD properties.
+type PatternProperties struct {
+	PatternPropertiesConfig
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the PatternProperties validation.
+func (pp *PatternProperties) Name() string {
+	return patternPropertiesValidationName
+}
+
+// SetEnforcement sets the EnforcementPolicy for the PatternProperties validation.
+func (pp *PatternProperties) SetEnforcement(policy config.EnforcementPolicy) {
+	pp.enforcement = policy
+}
+
+// Compare compares an old and a new JSONSchemaProps, checking for pattern properties that were added, removed or
+// had their pattern changed. A removed and an added pattern property with the same schema are treated as a changed pattern.
+// Changes to the schema of a pattern property whose pattern did not change are evaluated as properties of their own.
+// In order for callers to determine if diffs to a JSONSchemaProps have been handled by this validation
+// the JSONSchemaProps.PatternProperties field will be reset to 'nil' as part of this method.
+// It is highly recommended that only copies of the JSONSchemaProps to compare are provided to this method
+// to prevent unintentional modifications.
+func (pp *PatternProperties) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.ComparisonResult {
+	errs := []error{}
+
+	oldPatterns := sets.KeySet(a.PatternProperties)
+	newPatterns := sets.KeySet(b.PatternProperties)
+	added := newPatterns.Difference(oldPatterns)
+
+	for _, oldPattern := range sets.List(oldPatterns.Difference(newPatterns)) {
+		oldSchema := a.PatternProperties[oldPattern]
+
+		newPattern, changed := "", false
+		for _, candidate := range sets.List(added) {
+			if equality.Semantic.DeepEqual(oldSchema, b.PatternProperties[candidate]) {
+				newPattern, changed = candidate, true
+				break
+			}
+		}
+
+		if !changed {
+			if pp.RemovalPolicy != PatternPropertiesRemovalPolicyAllow {
+				errs = append(errs, fmt.Errorf("%w : %q : values of keys matching the pattern are no longer validated against its schema", ErrPatternPropertyRemoved, oldPattern))
+			}
+
+			continue
+		}
+
+		added.Delete(newPattern)
+
+		if err := pp.comparePatternKeys(oldPattern, newPattern); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, newPattern := range sets.List(added) {
+		errs = append(errs, fmt.Errorf("%w : %q : values of keys matching the pattern are now validated against its schema", ErrPatternPropertyAdded, newPattern))
+	}
+
+	a.PatternProperties = nil
+	b.PatternProperties = nil
+
+	return validations.HandleErrors(pp.Name(), pp.enforcement, errs...)
+}
+
+// comparePatternKeys compares the old and new pattern of a pattern property, returning an error
+// when the new pattern matches keys the old pattern did not, when the new pattern no longer matches
+// keys the old pattern matched, or when that can not be determined.
+func (pp *PatternProperties) comparePatternKeys(oldPattern, newPattern string) error {
+	if pp.AnalysisPolicy == PatternAnalysisPolicyNone {
+		return fmt.Errorf("%w : %q -> %q", ErrPatternPropertyChanged, oldPattern, newPattern)
+	}
+
+	keepsMatches, unmatched, err := patternAcceptsSuperset(oldPattern, newPattern)
+	if err != nil {
+		return fmt.Errorf("%w : %q -> %q : unable to determine whether the change is compatible: %w", ErrPatternPropertyChanged, oldPattern, newPattern, err)
+	}
+
+	noNewMatches, matched, err := patternAcceptsSuperset(newPattern, oldPattern)
+	if err != nil {
+		return fmt.Errorf("%w : %q -> %q : unable to determine whether the change is compatible: %w", ErrPatternPropertyChanged, oldPattern, newPattern, err)
+	}
+
+	impacts := []string{}
+
+	if !noNewMatches {
+		impacts = append(impacts, fmt.Sprintf("values of keys like %q are now validated against its schema", matched))
+	}
+
+	if !keepsMatches && pp.RemovalPolicy != PatternPropertiesRemovalPolicyAllow {
+		impacts = append(impacts, fmt.Sprintf("values of keys like %q are no longer validated against its schema", unmatched))
+	}
+
+	if len(impacts) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w : %q -> %q : %s", ErrPatternPropertyChanged, oldPattern, newPattern, strings.Join(impacts, ", "))
+}
+
+// ErrPatternPropertyAdded represents an error state when a pattern property was added to a property.
+var ErrPatternPropertyAdded = errors.New("pattern property added")
+
+// ErrPatternPropertyRemoved represents an error state when a pattern property was removed from a property.
+var ErrPatternPropertyRemoved = errors.New("pattern property removed")
+
+// ErrPatternPropertyChanged represents an error state when the pattern of a pattern property changed.
+var ErrPatternPropertyChanged = errors.New("pattern property changed")
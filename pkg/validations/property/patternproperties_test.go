@@ -0,0 +1,223 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/crdify/pkg/config"
+	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
+)
+
+func TestPatternProperties(t *testing.T) {
+	testcases := []internaltesting.Testcase[apiextensionsv1.JSONSchemaProps]{
+		{
+			Name: "no diff, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{"^a+$": {Type: "string"}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{"^a+$": {Type: "string"}},
+			},
+			Flagged:              false,
+			ComparableValidation: &PatternProperties{},
+		},
+		{
+			Name: "schema of pattern property changed, evaluated as its own property, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{"^a+$": {Type: "string"}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{"^a+$": {Type: "integer"}},
+			},
+			Flagged:              false,
+			ComparableValidation: &PatternProperties{},
+		},
+		{
+			Name: "pattern property added, flagged",
+			Old:  &apiextensionsv1.JSONSchemaProps{},
+			New: &apiextensionsv1.JSONSchemaProps{
+				PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{"^a+$": {Type: "string"}},
+			},
+			Flagged:              true,
+			ComparableValidation: &PatternProperties{},
+		},
+		{
+			Name: "pattern property removed, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{"^a+$": {Type: "string"}},
+			},
+			New:                  &apiextensionsv1.JSONSchemaProps{},
+			Flagged:              true,
+			ComparableValidation: &PatternProperties{},
+		},
+		{
+			Name: "pattern property removed, removal policy set to Allow, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{"^a+$": {Type: "string"}},
+			},
+			New:     &apiextensionsv1.JSONSchemaProps{},
+			Flagged: false,
+			ComparableValidation: &PatternProperties{
+				PatternPropertiesConfig: PatternPropertiesConfig{RemovalPolicy: PatternPropertiesRemovalPolicyAllow},
+			},
+		},
+		{
+			Name: "pattern of pattern property rewritten to an equivalent pattern, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{"^a+$": {Type: "string"}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{"^(a)+$": {Type: "string"}},
+			},
+			Flagged:              false,
+			ComparableValidation: &PatternProperties{},
+		},
+		{
+			Name: "pattern of pattern property widened, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{"^a+$": {Type: "string"}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{"^[ab]+$": {Type: "string"}},
+			},
+			Flagged:              true,
+			ComparableValidation: &PatternProperties{},
+		},
+		{
+			Name: "pattern of pattern property narrowed, removal policy set to Allow, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{"^[ab]+$": {Type: "string"}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{"^a+$": {Type: "string"}},
+			},
+			Flagged: false,
+			ComparableValidation: &PatternProperties{
+				PatternPropertiesConfig: PatternPropertiesConfig{RemovalPolicy: PatternPropertiesRemovalPolicyAllow},
+			},
+		},
+		{
+			Name: "pattern of pattern property rewritten, analysis policy set to None, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{"^a+$": {Type: "string"}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{"^(a)+$": {Type: "string"}},
+			},
+			Flagged: true,
+			ComparableValidation: &PatternProperties{
+				PatternPropertiesConfig: PatternPropertiesConfig{AnalysisPolicy: PatternAnalysisPolicyNone},
+			},
+		},
+		{
+			Name: "different field changed, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				ID: "foo",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				ID: "bar",
+			},
+			Flagged:              false,
+			ComparableValidation: &PatternProperties{},
+		},
+	}
+
+	internaltesting.RunTestcases(t, testcases...)
+}
+
+func TestPatternPropertiesChangedPattern(t *testing.T) {
+	val := &PatternProperties{}
+	val.SetEnforcement(config.EnforcementPolicyError)
+
+	result := val.Compare(
+		&apiextensionsv1.JSONSchemaProps{PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{"^[ab]+$": {Type: "string"}, "^c$": {Type: "string"}}},
+		&apiextensionsv1.JSONSchemaProps{PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{"^[a0]+$": {Type: "string"}, "^c$": {Type: "integer"}}},
+	)
+
+	expected := []string{
+		`pattern property changed : "^[ab]+$" -> "^[a0]+$" : values of keys like "0" are now validated against its schema, values of keys like "b" are no longer validated against its schema`,
+	}
+
+	if !slices.Equal(result.Errors, expected) {
+		t.Fatalf("expected errors %q, got %q", expected, result.Errors)
+	}
+}
+
+func TestValidatePatternPropertiesConfig(t *testing.T) {
+	testcases := []struct {
+		name               string
+		cfg                *PatternPropertiesConfig
+		wantErr            error
+		wantRemovalPolicy  PatternPropertiesRemovalPolicy
+		wantAnalysisPolicy PatternAnalysisPolicy
+	}{
+		{
+			name: "nil config",
+			cfg:  nil,
+		},
+		{
+			name:               "defaults removal and analysis policies",
+			cfg:                &PatternPropertiesConfig{},
+			wantRemovalPolicy:  PatternPropertiesRemovalPolicyDisallow,
+			wantAnalysisPolicy: PatternAnalysisPolicySubset,
+		},
+		{
+			name:               "allows valid policies",
+			cfg:                &PatternPropertiesConfig{RemovalPolicy: PatternPropertiesRemovalPolicyAllow, AnalysisPolicy: PatternAnalysisPolicyNone},
+			wantRemovalPolicy:  PatternPropertiesRemovalPolicyAllow,
+			wantAnalysisPolicy: PatternAnalysisPolicyNone,
+		},
+		{
+			name:    "invalid removal policy",
+			cfg:     &PatternPropertiesConfig{RemovalPolicy: "invalid"},
+			wantErr: errUnknownPatternPropertiesRemovalPolicy,
+		},
+		{
+			name:    "invalid analysis policy",
+			cfg:     &PatternPropertiesConfig{AnalysisPolicy: "invalid"},
+			wantErr: errUnknownPatternPropertiesAnalysisPolicy,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidatePatternPropertiesConfig(tc.cfg)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tc.cfg != nil && tc.cfg.RemovalPolicy != tc.wantRemovalPolicy {
+				t.Fatalf("expected removal policy %q, got %q", tc.wantRemovalPolicy, tc.cfg.RemovalPolicy)
+			}
+
+			if tc.cfg != nil && tc.cfg.AnalysisPolicy != tc.wantAnalysisPolicy {
+				t.Fatalf("expected analysis policy %q, got %q", tc.wantAnalysisPolicy, tc.cfg.AnalysisPolicy)
+			}
+		})
+	}
+}
@@ -0,0 +1,403 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"fmt"
+	"regexp/syntax"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// patternAnalysisBudget is the maximum number of automaton transitions
+// that are evaluated when comparing two patterns before giving up.
+const patternAnalysisBudget = 1 << 16
+
+var (
+	errPatternUnsupported          = errors.New("pattern uses unsupported assertions")
+	errPatternAnalysisTooExpensive = errors.New("pattern analysis exceeded its budget")
+)
+
+// patternAcceptsSuperset returns whether every string matched by the oldPattern
+// is also matched by the newPattern. Patterns match when they match any part of
+// a string, the same way the API server evaluates them.
+// When the newPattern does not match every string matched by the oldPattern, the
+// shortest string that is matched by the oldPattern but not by the newPattern is returned.
+// An error is returned when either pattern can not be analyzed, or when the analysis
+// is too costly, in which case the result is undecided.
+func patternAcceptsSuperset(oldPattern, newPattern string) (bool, string, error) {
+	oldProg, err := compilePattern(oldPattern)
+	if err != nil {
+		return false, "", fmt.Errorf("analyzing %q: %w", oldPattern, err)
+	}
+
+	newProg, err := compilePattern(newPattern)
+	if err != nil {
+		return false, "", fmt.Errorf("analyzing %q: %w", newPattern, err)
+	}
+
+	alphabet := runeClasses(oldProg, newProg)
+	oldAutomaton := newPatternAutomaton(oldProg, alphabet)
+	newAutomaton := newPatternAutomaton(newProg, alphabet)
+
+	start := patternStatePair{old: oldAutomaton.start(), new: newAutomaton.start()}
+	seen := map[patternStatePair]bool{start: true}
+	queue := []patternVisit{{patternStatePair: start, parent: -1, class: -1}}
+	steps := 0
+
+	// breadth first search over the product of both automata so
+	// that the first counterexample found is the shortest one.
+	for i := 0; i < len(queue); i++ {
+		current := queue[i]
+
+		if oldAutomaton.accepts(current.old) && !newAutomaton.accepts(current.new) {
+			return false, counterexample(queue, i, alphabet), nil
+		}
+
+		// the new pattern matches any string starting with the input
+		// so far, no counterexample can be found from here.
+		if current.new.matched {
+			continue
+		}
+
+		for class := range alphabet {
+			steps++
+			if steps > patternAnalysisBudget {
+				return false, "", errPatternAnalysisTooExpensive
+			}
+
+			next := patternStatePair{old: oldAutomaton.step(current.old, class), new: newAutomaton.step(current.new, class)}
+			if seen[next] {
+				continue
+			}
+
+			seen[next] = true
+			queue = append(queue, patternVisit{patternStatePair: next, parent: i, class: class})
+		}
+	}
+
+	return true, "", nil
+}
+
+// patternStatePair is a state of the product of the automata of two patterns.
+type patternStatePair struct {
+	old, new *patternState
+}
+
+// patternVisit is a visited patternStatePair, along with the
+// visit it was reached from and the class of the rune consumed to reach it.
+type patternVisit struct {
+	patternStatePair
+	parent int
+	class  int
+}
+
+// counterexample reconstructs the input that leads to the i-th visit.
+func counterexample(visits []patternVisit, i int, alphabet []runeClass) string {
+	runes := []rune{}
+
+	for v := visits[i]; v.parent >= 0; v = visits[v.parent] {
+		runes = append(runes, alphabet[v.class].example)
+	}
+
+	slices.Reverse(runes)
+
+	return string(runes)
+}
+
+// compilePattern compiles a pattern the same way the regexp package does,
+// ensuring it only uses assertions that can be analyzed.
+func compilePattern(pattern string) (*syntax.Prog, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+
+	prog, err := syntax.Compile(re.Simplify())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, inst := range prog.Inst {
+		if inst.Op == syntax.InstEmptyWidth && syntax.EmptyOp(inst.Arg)&^(syntax.EmptyBeginText|syntax.EmptyEndText) != 0 {
+			return nil, errPatternUnsupported
+		}
+	}
+
+	return prog, nil
+}
+
+// runeClass is a range of runes that every instruction
+// of the analyzed patterns treats the same way.
+type runeClass struct {
+	lo, hi rune
+	// example is the rune of the class used when building counterexamples.
+	example rune
+}
+
+// runeClasses partitions all runes into the ranges that
+// the instructions of the provided programs distinguish.
+func runeClasses(progs ...*syntax.Prog) []runeClass {
+	boundaries := map[rune]bool{0: true, unicode.MaxRune + 1: true, '\n': true, '\n' + 1: true}
+
+	for _, prog := range progs {
+		for _, inst := range prog.Inst {
+			switch inst.Op {
+			case syntax.InstRune:
+				if len(inst.Rune) == 1 && syntax.Flags(inst.Arg)&syntax.FoldCase != 0 {
+					for r := unicode.SimpleFold(inst.Rune[0]); r != inst.Rune[0]; r = unicode.SimpleFold(r) {
+						boundaries[r], boundaries[r+1] = true, true
+					}
+
+					boundaries[inst.Rune[0]], boundaries[inst.Rune[0]+1] = true, true
+
+					continue
+				}
+
+				for i := 0; i+1 < len(inst.Rune); i += 2 {
+					boundaries[inst.Rune[i]], boundaries[inst.Rune[i+1]+1] = true, true
+				}
+
+				if len(inst.Rune) == 1 {
+					boundaries[inst.Rune[0]], boundaries[inst.Rune[0]+1] = true, true
+				}
+			case syntax.InstRune1:
+				boundaries[inst.Rune[0]], boundaries[inst.Rune[0]+1] = true, true
+			}
+		}
+	}
+
+	sorted := make([]rune, 0, len(boundaries))
+	for boundary := range boundaries {
+		sorted = append(sorted, boundary)
+	}
+
+	slices.Sort(sorted)
+
+	classes := make([]runeClass, 0, len(sorted)-1)
+	for i := 0; i+1 < len(sorted); i++ {
+		lo, hi := sorted[i], sorted[i+1]-1
+		classes = append(classes, runeClass{lo: lo, hi: hi, example: exampleRune(lo, hi)})
+	}
+
+	// classes with the most readable examples are tried first
+	// so that counterexamples are as readable as possible.
+	slices.SortStableFunc(classes, func(a, b runeClass) int {
+		return exampleRank(a.example) - exampleRank(b.example)
+	})
+
+	return classes
+}
+
+// readableRunes are the runes preferred in counterexamples, in order of preference.
+const readableRunes = "abcdefghijklmnopqrstuvwxyz0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ-_."
+
+// exampleRune returns a readable rune in the range lo-hi, if there is one.
+func exampleRune(lo, hi rune) rune {
+	for _, r := range readableRunes {
+		if lo <= r && r <= hi {
+			return r
+		}
+	}
+
+	for r := lo; r <= hi && r < lo+0x100; r++ {
+		if unicode.IsPrint(r) {
+			return r
+		}
+	}
+
+	return lo
+}
+
+// exampleRank ranks how readable a rune is in a counterexample, lower is more readable.
+func exampleRank(r rune) int {
+	if i := strings.IndexRune(readableRunes, r); i >= 0 {
+		return i
+	}
+
+	if unicode.IsPrint(r) {
+		return len(readableRunes)
+	}
+
+	return len(readableRunes) + 1
+}
+
+// patternState is a state of the deterministic automaton of a pattern.
+type patternState struct {
+	// kernel is the set of instructions reached by the input so far,
+	// before following any empty transitions.
+	kernel []uint32
+	// atStart is whether no input has been consumed yet.
+	atStart bool
+	// matched is whether the pattern matched part of the input so far,
+	// in which case the pattern matches any input starting with it.
+	matched bool
+	next    map[int]*patternState
+}
+
+// patternAutomaton lazily builds the deterministic automaton of a pattern
+// that matches when the pattern matches any part of the input.
+type patternAutomaton struct {
+	prog     *syntax.Prog
+	alphabet []runeClass
+	states   map[string]*patternState
+	matched  *patternState
+}
+
+func newPatternAutomaton(prog *syntax.Prog, alphabet []runeClass) *patternAutomaton {
+	matched := &patternState{matched: true}
+
+	return &patternAutomaton{
+		prog:     prog,
+		alphabet: alphabet,
+		states:   map[string]*patternState{},
+		matched:  matched,
+	}
+}
+
+// start returns the state of the automaton before consuming any input.
+func (pa *patternAutomaton) start() *patternState {
+	return pa.state([]uint32{uint32(pa.prog.Start)}, true)
+}
+
+// step returns the state of the automaton after consuming a rune of the provided class.
+func (pa *patternAutomaton) step(s *patternState, class int) *patternState {
+	if s.matched {
+		return s
+	}
+
+	if next, ok := s.next[class]; ok {
+		return next
+	}
+
+	r := pa.alphabet[class].lo
+	reached, _ := pa.closure(s.kernel, emptyOpsAt(s.atStart, false))
+
+	// a match may start at any position of the input
+	kernel := []uint32{uint32(pa.prog.Start)}
+
+	for _, pc := range reached {
+		inst := &pa.prog.Inst[pc]
+
+		switch inst.Op {
+		case syntax.InstRune, syntax.InstRune1, syntax.InstRuneAny, syntax.InstRuneAnyNotNL:
+			if inst.MatchRune(r) {
+				kernel = append(kernel, inst.Out)
+			}
+		}
+	}
+
+	next := pa.state(kernel, false)
+	s.next[class] = next
+
+	return next
+}
+
+// accepts returns whether the pattern matches the input that led to the provided state.
+func (pa *patternAutomaton) accepts(s *patternState) bool {
+	if s.matched {
+		return true
+	}
+
+	_, matched := pa.closure(s.kernel, emptyOpsAt(s.atStart, true))
+
+	return matched
+}
+
+// state returns the, possibly already known, state for the provided kernel.
+func (pa *patternAutomaton) state(kernel []uint32, atStart bool) *patternState {
+	if _, matched := pa.closure(kernel, emptyOpsAt(atStart, false)); matched {
+		return pa.matched
+	}
+
+	slices.Sort(kernel)
+	kernel = slices.Compact(kernel)
+
+	parts := make([]string, 0, len(kernel)+1)
+	parts = append(parts, strconv.FormatBool(atStart))
+
+	for _, pc := range kernel {
+		parts = append(parts, strconv.FormatUint(uint64(pc), 10))
+	}
+
+	key := strings.Join(parts, ",")
+	if s, ok := pa.states[key]; ok {
+		return s
+	}
+
+	s := &patternState{kernel: kernel, atStart: atStart, next: map[int]*patternState{}}
+	pa.states[key] = s
+
+	return s
+}
+
+// closure follows the empty transitions, satisfying the provided empty width assertions,
+// from the provided instructions. It returns the reached instructions and
+// whether the match instruction was reached.
+func (pa *patternAutomaton) closure(kernel []uint32, ops syntax.EmptyOp) ([]uint32, bool) {
+	visited := make([]bool, len(pa.prog.Inst))
+	stack := slices.Clone(kernel)
+	reached := []uint32{}
+	matched := false
+
+	for len(stack) > 0 {
+		pc := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if visited[pc] {
+			continue
+		}
+
+		visited[pc] = true
+		inst := &pa.prog.Inst[pc]
+
+		switch inst.Op {
+		case syntax.InstAlt, syntax.InstAltMatch:
+			stack = append(stack, inst.Out, inst.Arg)
+		case syntax.InstCapture, syntax.InstNop:
+			stack = append(stack, inst.Out)
+		case syntax.InstEmptyWidth:
+			if syntax.EmptyOp(inst.Arg)&^ops == 0 {
+				stack = append(stack, inst.Out)
+			}
+		case syntax.InstMatch:
+			matched = true
+		case syntax.InstRune, syntax.InstRune1, syntax.InstRuneAny, syntax.InstRuneAnyNotNL:
+			reached = append(reached, pc)
+		case syntax.InstFail:
+			// dead end
+		}
+	}
+
+	return reached, matched
+}
+
+// emptyOpsAt returns the empty width assertions that hold at a position of the input.
+func emptyOpsAt(atStart, atEnd bool) syntax.EmptyOp {
+	var ops syntax.EmptyOp
+
+	if atStart {
+		ops |= syntax.EmptyBeginText
+	}
+
+	if atEnd {
+		ops |= syntax.EmptyEndText
+	}
+
+	return ops
+}
@@ -0,0 +1,178 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+)
+
+func TestPatternAcceptsSuperset(t *testing.T) {
+	testcases := []struct {
+		name         string
+		oldPattern   string
+		newPattern   string
+		wantSuperset bool
+		wantExample  string
+	}{
+		{
+			name:         "character class widened",
+			oldPattern:   "^[a-z]+$",
+			newPattern:   "^[a-z0-9]+$",
+			wantSuperset: true,
+		},
+		{
+			name:        "character class narrowed",
+			oldPattern:  "^[a-z0-9]+$",
+			newPattern:  "^[a-z]+$",
+			wantExample: "0",
+		},
+		{
+			name:        "disjoint character classes",
+			oldPattern:  "^[a-z]+$",
+			newPattern:  "^[A-Z]+$",
+			wantExample: "a",
+		},
+		{
+			name:         "anchor removed",
+			oldPattern:   "^abc$",
+			newPattern:   "abc",
+			wantSuperset: true,
+		},
+		{
+			name:        "anchor added",
+			oldPattern:  "abc",
+			newPattern:  "^abc",
+			wantExample: "aabc",
+		},
+		{
+			name:         "unanchored pattern shortened",
+			oldPattern:   "abc",
+			newPattern:   "b",
+			wantSuperset: true,
+		},
+		{
+			name:        "repetition bound lowered",
+			oldPattern:  "^a{1,6}$",
+			newPattern:  "^a{1,5}$",
+			wantExample: "aaaaaa",
+		},
+		{
+			name:         "repetition bound raised",
+			oldPattern:   "^a{1,5}$",
+			newPattern:   "^a{1,6}$",
+			wantSuperset: true,
+		},
+		{
+			name:         "case insensitivity added",
+			oldPattern:   "^abc$",
+			newPattern:   "^(?i)abc$",
+			wantSuperset: true,
+		},
+		{
+			name:        "case insensitivity removed",
+			oldPattern:  "^(?i)abc$",
+			newPattern:  "^abc$",
+			wantExample: "abC",
+		},
+		{
+			name:         "unicode class widened to any character",
+			oldPattern:   `^\p{L}+$`,
+			newPattern:   "^.+$",
+			wantSuperset: true,
+		},
+		{
+			name:        "any character narrowed to unicode class",
+			oldPattern:  "^.+$",
+			newPattern:  `^\p{L}+$`,
+			wantExample: "0",
+		},
+		{
+			name:         "dns label widened",
+			oldPattern:   "^[a-z0-9]([-a-z0-9]*[a-z0-9])?$",
+			newPattern:   "^[a-z0-9-]+$",
+			wantSuperset: true,
+		},
+		{
+			name:         "pattern that matches everything",
+			oldPattern:   "^[a-z]+$",
+			newPattern:   "",
+			wantSuperset: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			superset, example, err := patternAcceptsSuperset(tc.oldPattern, tc.newPattern)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if superset != tc.wantSuperset {
+				t.Fatalf("expected superset to be %t, got %t", tc.wantSuperset, superset)
+			}
+
+			if example != tc.wantExample {
+				t.Fatalf("expected example %q, got %q", tc.wantExample, example)
+			}
+
+			if !superset && (!regexp.MustCompile(tc.oldPattern).MatchString(example) || regexp.MustCompile(tc.newPattern).MatchString(example)) {
+				t.Fatalf("expected example %q to be accepted by %q and rejected by %q", example, tc.oldPattern, tc.newPattern)
+			}
+		})
+	}
+}
+
+func TestPatternAcceptsSupersetUndecided(t *testing.T) {
+	testcases := []struct {
+		name       string
+		oldPattern string
+		newPattern string
+		wantErr    error
+	}{
+		{
+			name:       "word boundaries are not supported",
+			oldPattern: `\bfoo`,
+			newPattern: "foo",
+			wantErr:    errPatternUnsupported,
+		},
+		{
+			name:       "multi-line anchors are not supported",
+			oldPattern: "foo",
+			newPattern: "(?m)^foo$",
+			wantErr:    errPatternUnsupported,
+		},
+		{
+			name:       "automaton too large",
+			oldPattern: "[ab]*a[ab]{24}",
+			newPattern: "[ab]*a[ab]{24}c",
+			wantErr:    errPatternAnalysisTooExpensive,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := patternAcceptsSuperset(tc.oldPattern, tc.newPattern)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+
+	if _, _, err := patternAcceptsSuperset("(?<name", "foo"); err == nil {
+		t.Fatal("expected an error for an invalid pattern")
+	}
+}
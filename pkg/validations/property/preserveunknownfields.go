@@ -0,0 +1,201 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+)
+
+var (
+	_ validations.Validation                                  = (*PreserveUnknownFields)(nil)
+	_ validations.Comparator[apiextensionsv1.JSONSchemaProps] = (*PreserveUnknownFields)(nil)
+)
+
+const preserveUnknownFieldsValidationName = "preserveUnknownFields"
+
+// RegisterPreserveUnknownFields registers the PreserveUnknownFields validation
+// with the provided validation registry.
+func RegisterPreserveUnknownFields(registry validations.Registry) {
+	registry.Register(preserveUnknownFieldsValidationName, preserveUnknownFieldsFactory)
+}
+
+// preserveUnknownFieldsFactory is a function used to initialize a PreserveUnknownFields validation
+// implementation based on the provided configuration.
+func preserveUnknownFieldsFactory(cfg map[string]interface{}) (validations.Validation, error) {
+	preserveCfg := &PreserveUnknownFieldsConfig{}
+
+	err := ConfigToType(cfg, preserveCfg)
+	if err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	err = ValidatePreserveUnknownFieldsConfig(preserveCfg)
+	if err != nil {
+		return nil, fmt.Errorf("validating preserveUnknownFields config: %w", err)
+	}
+
+	return &PreserveUnknownFields{PreserveUnknownFieldsConfig: *preserveCfg}, nil
+}
+
+// ValidatePreserveUnknownFieldsConfig ensures provided PreserveUnknownFieldsConfig is valid and defaults missing values.
+func ValidatePreserveUnknownFieldsConfig(in *PreserveUnknownFieldsConfig) error {
+	if in == nil {
+		return nil
+	}
+
+	switch in.AdditionPolicy {
+	case PreserveUnknownFieldsAdditionPolicyAllow, PreserveUnknownFieldsAdditionPolicyDisallow:
+		// valid entries
+	case PreserveUnknownFieldsAdditionPolicy(""):
+		in.AdditionPolicy = PreserveUnknownFieldsAdditionPolicyDisallow
+	default:
+		return fmt.Errorf("%w : %q (valid values: %q, %q)", errUnknownPreserveUnknownFieldsAdditionPolicy, in.AdditionPolicy, PreserveUnknownFieldsAdditionPolicyAllow, PreserveUnknownFieldsAdditionPolicyDisallow)
+	}
+
+	switch in.RemovalPolicy {
+	case PreserveUnknownFieldsRemovalPolicyAllow, PreserveUnknownFieldsRemovalPolicyDisallow:
+		// valid entries
+	case PreserveUnknownFieldsRemovalPolicy(""):
+		in.RemovalPolicy = PreserveUnknownFieldsRemovalPolicyDisallow
+	default:
+		return fmt.Errorf("%w : %q (valid values: %q, %q)", errUnknownPreserveUnknownFieldsRemovalPolicy, in.RemovalPolicy, PreserveUnknownFieldsRemovalPolicyAllow, PreserveUnknownFieldsRemovalPolicyDisallow)
+	}
+
+	return nil
+}
+
+var (
+	errUnknownPreserveUnknownFieldsAdditionPolicy = errors.New("unknown addition policy")
+	errUnknownPreserveUnknownFieldsRemovalPolicy  = errors.New("unknown removal policy")
+)
+
+// PreserveUnknownFieldsAdditionPolicy represents how enabling x-kubernetes-preserve-unknown-fields should be evaluated.
+type PreserveUnknownFieldsAdditionPolicy string
+
+const (
+	// PreserveUnknownFieldsAdditionPolicyAllow treats preserving unknown fields when they were previously pruned as compatible.
+	PreserveUnknownFieldsAdditionPolicyAllow PreserveUnknownFieldsAdditionPolicy = "Allow"
+	// PreserveUnknownFieldsAdditionPolicyDisallow treats preserving unknown fields when they were previously pruned as incompatible.
+	PreserveUnknownFieldsAdditionPolicyDisallow PreserveUnknownFieldsAdditionPolicy = "Disallow"
+)
+
+// PreserveUnknownFieldsRemovalPolicy represents how disabling x-kubernetes-preserve-unknown-fields should be evaluated.
+type PreserveUnknownFieldsRemovalPolicy string
+
+const (
+	// PreserveUnknownFieldsRemovalPolicyAllow treats pruning unknown fields when they were previously preserved as compatible.
+	PreserveUnknownFieldsRemovalPolicyAllow PreserveUnknownFieldsRemovalPolicy = "Allow"
+	// PreserveUnknownFieldsRemovalPolicyDisallow treats pruning unknown fields when they were previously preserved as incompatible.
+	PreserveUnknownFieldsRemovalPolicyDisallow PreserveUnknownFieldsRemovalPolicy = "Disallow"
+)
+
+// PreserveUnknownFieldsConfig contains additional configuration for the PreserveUnknownFields validation.
+type PreserveUnknownFieldsConfig struct {
+	// AdditionPolicy dictates whether preserving unknown fields when they were previously pruned is compatible.
+	// Allowed values are Allow and Disallow. Defaults to Disallow.
+	AdditionPolicy PreserveUnknownFieldsAdditionPolicy `json:"additionPolicy,omitempty"`
+	// RemovalPolicy dictates whether pruning unknown fields when they were previously preserved is compatible.
+	// Allowed values are Allow and Disallow. Defaults to Disallow.
+	RemovalPolicy PreserveUnknownFieldsRemovalPolicy `json:"removalPolicy,omitempty"`
+}
+
+// PreserveUnknownFields is a Validation that can be used to identify
+// incompatible changes to the x-kubernetes-preserve-unknown-fields
+// setting of CRD properties.
+type PreserveUnknownFields struct {
+	PreserveUnknownFieldsConfig
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the PreserveUnknownFields validation.
+func (p *PreserveUnknownFields) Name() string {
+	return preserveUnknownFieldsValidationName
+}
+
+// SetEnforcement sets the EnforcementPolicy for the PreserveUnknownFields validation.
+func (p *PreserveUnknownFields) SetEnforcement(policy config.EnforcementPolicy) {
+	p.enforcement = policy
+}
+
+// Compare compares an old and a new JSONSchemaProps, checking for changes to the x-kubernetes-preserve-unknown-fields setting of a property.
+// In order for callers to determine if diffs to a JSONSchemaProps have been handled by this validation
+// the JSONSchemaProps.XPreserveUnknownFields field will be reset to 'nil' as part of this method.
+// It is highly recommended that only copies of the JSONSchemaProps to compare are provided to this method
+// to prevent unintentional modifications.
+func (p *PreserveUnknownFields) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.ComparisonResult {
+	var err error
+
+	oldPreserve := ptr.Deref(a.XPreserveUnknownFields, false)
+	newPreserve := ptr.Deref(b.XPreserveUnknownFields, false)
+
+	switch {
+	case oldPreserve == newPreserve:
+		// nothing to do
+	case !oldPreserve && newPreserve && p.AdditionPolicy != PreserveUnknownFieldsAdditionPolicyAllow:
+		err = fmt.Errorf("%w : %t -> %t : unknown fields are no longer pruned or rejected, affected subtree: %v",
+			ErrPreserveUnknownFieldsEnabled, oldPreserve, newPreserve, affectedSubtree(b))
+	case oldPreserve && !newPreserve && p.RemovalPolicy != PreserveUnknownFieldsRemovalPolicyAllow:
+		err = fmt.Errorf("%w : %t -> %t : unknown fields of stored objects are pruned on their next write, affected subtree: %v",
+			ErrPreserveUnknownFieldsDisabled, oldPreserve, newPreserve, affectedSubtree(b))
+	}
+
+	a.XPreserveUnknownFields = nil
+	b.XPreserveUnknownFields = nil
+
+	return validations.HandleErrors(p.Name(), p.enforcement, err)
+}
+
+// affectedSubtree returns the paths, relative to s, of s and the descendants of s
+// whose unknown fields are affected by changing x-kubernetes-preserve-unknown-fields on s.
+// Descendants that set x-kubernetes-preserve-unknown-fields themselves, and their descendants,
+// are not affected because they keep preserving unknown fields regardless of s.
+func affectedSubtree(s *apiextensionsv1.JSONSchemaProps) []string {
+	affected := []string{}
+
+	validations.SchemaHas(s, field.NewPath("^"), field.NewPath("^"), nil,
+		func(child *apiextensionsv1.JSONSchemaProps, _, simpleLocation *field.Path, ancestry []*apiextensionsv1.JSONSchemaProps) bool {
+			// ancestry[0] is s itself, which is always affected
+			descendants := append(slices.Clone(ancestry), child)
+			if len(descendants) > 1 && slices.ContainsFunc(descendants[1:], func(d *apiextensionsv1.JSONSchemaProps) bool {
+				return ptr.Deref(d.XPreserveUnknownFields, false)
+			}) {
+				return false
+			}
+
+			affected = append(affected, strings.Replace(simpleLocation.String(), "^", "self", 1))
+
+			return false
+		},
+	)
+
+	slices.Sort(affected)
+
+	return affected
+}
+
+// ErrPreserveUnknownFieldsEnabled represents an error state when a property starts preserving unknown fields.
+var ErrPreserveUnknownFieldsEnabled = errors.New("preserve unknown fields enabled")
+
+// ErrPreserveUnknownFieldsDisabled represents an error state when a property stops preserving unknown fields.
+var ErrPreserveUnknownFieldsDisabled = errors.New("preserve unknown fields disabled")
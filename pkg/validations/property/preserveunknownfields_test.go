@@ -0,0 +1,201 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
+)
+
+func TestPreserveUnknownFields(t *testing.T) {
+	testcases := []internaltesting.Testcase[apiextensionsv1.JSONSchemaProps]{
+		{
+			Name: "no diff, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				XPreserveUnknownFields: ptr.To(true),
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				XPreserveUnknownFields: ptr.To(true),
+			},
+			Flagged:              false,
+			ComparableValidation: &PreserveUnknownFields{},
+		},
+		{
+			Name: "unset to false, not flagged",
+			Old:  &apiextensionsv1.JSONSchemaProps{},
+			New: &apiextensionsv1.JSONSchemaProps{
+				XPreserveUnknownFields: ptr.To(false),
+			},
+			Flagged:              false,
+			ComparableValidation: &PreserveUnknownFields{},
+		},
+		{
+			Name: "enabled, flagged by default",
+			Old:  &apiextensionsv1.JSONSchemaProps{},
+			New: &apiextensionsv1.JSONSchemaProps{
+				XPreserveUnknownFields: ptr.To(true),
+			},
+			Flagged:              true,
+			ComparableValidation: &PreserveUnknownFields{},
+		},
+		{
+			Name: "enabled, allowed via config",
+			Old:  &apiextensionsv1.JSONSchemaProps{},
+			New: &apiextensionsv1.JSONSchemaProps{
+				XPreserveUnknownFields: ptr.To(true),
+			},
+			Flagged: false,
+			ComparableValidation: &PreserveUnknownFields{
+				PreserveUnknownFieldsConfig: PreserveUnknownFieldsConfig{AdditionPolicy: PreserveUnknownFieldsAdditionPolicyAllow},
+			},
+		},
+		{
+			Name: "disabled, flagged by default",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				XPreserveUnknownFields: ptr.To(true),
+			},
+			New:                  &apiextensionsv1.JSONSchemaProps{},
+			Flagged:              true,
+			ComparableValidation: &PreserveUnknownFields{},
+		},
+		{
+			Name: "disabled, allowed via config",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				XPreserveUnknownFields: ptr.To(true),
+			},
+			New:     &apiextensionsv1.JSONSchemaProps{},
+			Flagged: false,
+			ComparableValidation: &PreserveUnknownFields{
+				PreserveUnknownFieldsConfig: PreserveUnknownFieldsConfig{RemovalPolicy: PreserveUnknownFieldsRemovalPolicyAllow},
+			},
+		},
+		{
+			Name: "different field changed, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				ID: "foo",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				ID: "bar",
+			},
+			Flagged:              false,
+			ComparableValidation: &PreserveUnknownFields{},
+		},
+	}
+
+	internaltesting.RunTestcases(t, testcases...)
+}
+
+func TestAffectedSubtree(t *testing.T) {
+	schema := &apiextensionsv1.JSONSchemaProps{
+		Type: "object",
+		Properties: map[string]apiextensionsv1.JSONSchemaProps{
+			"foo": {
+				Type: "object",
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{
+					"bar": {Type: "string"},
+				},
+			},
+			"preserved": {
+				Type:                   "object",
+				XPreserveUnknownFields: ptr.To(true),
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{
+					"baz": {Type: "string"},
+				},
+			},
+			"list": {
+				Type: "array",
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+					Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []string{"self", "self.foo", "self.foo.bar", "self.list", "self.list.items"}, affectedSubtree(schema))
+}
+
+func TestValidatePreserveUnknownFieldsConfig(t *testing.T) {
+	testcases := []struct {
+		name               string
+		cfg                *PreserveUnknownFieldsConfig
+		wantErr            error
+		wantAdditionPolicy PreserveUnknownFieldsAdditionPolicy
+		wantRemovalPolicy  PreserveUnknownFieldsRemovalPolicy
+	}{
+		{
+			name: "nil config",
+			cfg:  nil,
+		},
+		{
+			name:               "defaults policies",
+			cfg:                &PreserveUnknownFieldsConfig{},
+			wantAdditionPolicy: PreserveUnknownFieldsAdditionPolicyDisallow,
+			wantRemovalPolicy:  PreserveUnknownFieldsRemovalPolicyDisallow,
+		},
+		{
+			name: "allows valid policies",
+			cfg: &PreserveUnknownFieldsConfig{
+				AdditionPolicy: PreserveUnknownFieldsAdditionPolicyAllow,
+				RemovalPolicy:  PreserveUnknownFieldsRemovalPolicyAllow,
+			},
+			wantAdditionPolicy: PreserveUnknownFieldsAdditionPolicyAllow,
+			wantRemovalPolicy:  PreserveUnknownFieldsRemovalPolicyAllow,
+		},
+		{
+			name:    "invalid addition policy",
+			cfg:     &PreserveUnknownFieldsConfig{AdditionPolicy: "invalid"},
+			wantErr: errUnknownPreserveUnknownFieldsAdditionPolicy,
+		},
+		{
+			name:    "invalid removal policy",
+			cfg:     &PreserveUnknownFieldsConfig{RemovalPolicy: "invalid"},
+			wantErr: errUnknownPreserveUnknownFieldsRemovalPolicy,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidatePreserveUnknownFieldsConfig(tc.cfg)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tc.cfg == nil {
+				return
+			}
+
+			if tc.cfg.AdditionPolicy != tc.wantAdditionPolicy {
+				t.Fatalf("expected addition policy %q, got %q", tc.wantAdditionPolicy, tc.cfg.AdditionPolicy)
+			}
+
+			if tc.cfg.RemovalPolicy != tc.wantRemovalPolicy {
+				t.Fatalf("expected removal policy %q, got %q", tc.wantRemovalPolicy, tc.cfg.RemovalPolicy)
+			}
+		})
+	}
+}
@@ -39,13 +39,64 @@ func RegisterRequired(registry validations.Registry) {
 
 // requiredFactory is a function used to initialize a Required validation
 // implementation based on the provided configuration.
-func requiredFactory(_ map[string]interface{}) (validations.Validation, error) {
-	return &Required{}, nil
+func requiredFactory(cfg map[string]interface{}) (validations.Validation, error) {
+	requiredCfg := &RequiredConfig{}
+
+	err := ConfigToType(cfg, requiredCfg)
+	if err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	err = ValidateRequiredConfig(requiredCfg)
+	if err != nil {
+		return nil, fmt.Errorf("validating required config: %w", err)
+	}
+
+	return &Required{RequiredConfig: *requiredCfg}, nil
+}
+
+// ValidateRequiredConfig ensures provided RequiredConfig is valid and defaults missing values.
+func ValidateRequiredConfig(in *RequiredConfig) error {
+	if in == nil {
+		return nil
+	}
+
+	switch in.DefaultedPolicy {
+	case RequiredDefaultedPolicyAllow, RequiredDefaultedPolicyDisallow:
+		// valid entries
+	case RequiredDefaultedPolicy(""):
+		in.DefaultedPolicy = RequiredDefaultedPolicyDisallow
+	default:
+		return fmt.Errorf("%w : %q (valid values: %q, %q)", errUnknownRequiredDefaultedPolicy, in.DefaultedPolicy, RequiredDefaultedPolicyAllow, RequiredDefaultedPolicyDisallow)
+	}
+
+	return nil
+}
+
+var errUnknownRequiredDefaultedPolicy = errors.New("unknown defaulted policy")
+
+// RequiredDefaultedPolicy represents how newly requiring a field that has a default should be evaluated.
+type RequiredDefaultedPolicy string
+
+const (
+	// RequiredDefaultedPolicyAllow treats newly requiring a field that has a default as compatible.
+	RequiredDefaultedPolicyAllow RequiredDefaultedPolicy = "Allow"
+	// RequiredDefaultedPolicyDisallow treats newly requiring a field that has a default as incompatible.
+	RequiredDefaultedPolicyDisallow RequiredDefaultedPolicy = "Disallow"
+)
+
+// RequiredConfig contains additional configuration for the Required validation.
+type RequiredConfig struct {
+	// DefaultedPolicy dictates whether newly requiring a field that has a default in the new schema is compatible.
+	// The API server fills in the default when the field is not set, so such fields do not break create requests.
+	// Allowed values are Allow and Disallow. Defaults to Disallow.
+	DefaultedPolicy RequiredDefaultedPolicy `json:"defaultedPolicy,omitempty"`
 }
 
 // Required is a Validation that can be used to identify
 // incompatible changes to the required constraints of CRD properties.
 type Required struct {
+	RequiredConfig
 	enforcement config.EnforcementPolicy
 }
 
@@ -60,6 +111,7 @@ func (r *Required) SetEnforcement(policy config.EnforcementPolicy) {
 }
 
 // Compare compares an old and a new JSONSchemaProps, checking for incompatible changes to the required constraints of a property.
+// The children property schemas of the new JSONSchemaProps are used to determine whether newly required fields have a default.
 // In order for callers to determine if diffs to a JSONSchemaProps have been handled by this validation
 // the JSONSchemaProps.Required field will be reset to 'nil' as part of this method.
 // It is highly recommended that only copies of the JSONSchemaProps to compare are provided to this method
@@ -69,10 +121,18 @@ func (r *Required) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.Co
 	newRequired := sets.New(b.Required...)
 	diffRequired := newRequired.Difference(oldRequired)
 
+	if r.DefaultedPolicy == RequiredDefaultedPolicyAllow {
+		for _, field := range diffRequired.UnsortedList() {
+			if property, ok := b.Properties[field]; ok && property.Default != nil {
+				diffRequired.Delete(field)
+			}
+		}
+	}
+
 	var err error
 
 	if diffRequired.Len() > 0 {
-		err = fmt.Errorf("%w: %v", ErrNewRequiredFields, diffRequired.UnsortedList())
+		err = fmt.Errorf("%w: %v", ErrNewRequiredFields, sets.List(diffRequired))
 	}
 
 	a.Required = nil
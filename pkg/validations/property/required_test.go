@@ -15,6 +15,7 @@
 package property
 
 import (
+	"errors"
 	"testing"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -49,6 +50,83 @@ func TestRequired(t *testing.T) {
 			Flagged:              true,
 			ComparableValidation: &Required{},
 		},
+		{
+			Name: "new required field with default, flagged by default",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{
+					"foo": {
+						Type:    "string",
+						Default: &apiextensionsv1.JSON{Raw: []byte(`"bar"`)},
+					},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Required: []string{
+					"foo",
+				},
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{
+					"foo": {
+						Type:    "string",
+						Default: &apiextensionsv1.JSON{Raw: []byte(`"bar"`)},
+					},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &Required{},
+		},
+		{
+			Name: "new required field with default, allowed via config",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{
+					"foo": {
+						Type:    "string",
+						Default: &apiextensionsv1.JSON{Raw: []byte(`"bar"`)},
+					},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Required: []string{
+					"foo",
+				},
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{
+					"foo": {
+						Type:    "string",
+						Default: &apiextensionsv1.JSON{Raw: []byte(`"bar"`)},
+					},
+				},
+			},
+			Flagged: false,
+			ComparableValidation: &Required{
+				RequiredConfig: RequiredConfig{DefaultedPolicy: RequiredDefaultedPolicyAllow},
+			},
+		},
+		{
+			Name: "new required field without default, flagged when defaulted fields are allowed",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{
+					"foo": {
+						Type:    "string",
+						Default: &apiextensionsv1.JSON{Raw: []byte(`"bar"`)},
+					},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Required: []string{
+					"foo",
+					"baz",
+				},
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{
+					"foo": {
+						Type:    "string",
+						Default: &apiextensionsv1.JSON{Raw: []byte(`"bar"`)},
+					},
+				},
+			},
+			Flagged: true,
+			ComparableValidation: &Required{
+				RequiredConfig: RequiredConfig{DefaultedPolicy: RequiredDefaultedPolicyAllow},
+			},
+		},
 		{
 			Name: "different field changed, not flagged",
 			Old: &apiextensionsv1.JSONSchemaProps{
@@ -64,3 +142,53 @@ func TestRequired(t *testing.T) {
 
 	internaltesting.RunTestcases(t, testcases...)
 }
+
+func TestValidateRequiredConfig(t *testing.T) {
+	testcases := []struct {
+		name                string
+		cfg                 *RequiredConfig
+		wantErr             error
+		wantDefaultedPolicy RequiredDefaultedPolicy
+	}{
+		{
+			name: "nil config",
+			cfg:  nil,
+		},
+		{
+			name:                "defaults defaulted policy",
+			cfg:                 &RequiredConfig{},
+			wantDefaultedPolicy: RequiredDefaultedPolicyDisallow,
+		},
+		{
+			name:                "allows valid defaulted policy",
+			cfg:                 &RequiredConfig{DefaultedPolicy: RequiredDefaultedPolicyAllow},
+			wantDefaultedPolicy: RequiredDefaultedPolicyAllow,
+		},
+		{
+			name:    "invalid defaulted policy mentions valid values",
+			cfg:     &RequiredConfig{DefaultedPolicy: "invalid"},
+			wantErr: errUnknownRequiredDefaultedPolicy,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateRequiredConfig(tc.cfg)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tc.cfg != nil && tc.cfg.DefaultedPolicy != tc.wantDefaultedPolicy {
+				t.Fatalf("expected defaulted policy %q, got %q", tc.wantDefaultedPolicy, tc.cfg.DefaultedPolicy)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+)
+
+var (
+	_ validations.Validation                                  = (*TupleItems)(nil)
+	_ validations.Comparator[apiextensionsv1.JSONSchemaProps] = (*TupleItems)(nil)
+)
+
+const tupleItemsValidationName = "tupleItems"
+
+// RegisterTupleItems registers the TupleItems validation
+// with the provided validation registry.
+func RegisterTupleItems(registry validations.Registry) {
+	registry.Register(tupleItemsValidationName, tupleItemsFactory)
+}
+
+// tupleItemsFactory is a function used to initialize a TupleItems validation
+// implementation based on the provided configuration.
+func tupleItemsFactory(_ map[string]interface{}) (validations.Validation, error) {
+	return &TupleItems{}, nil
+}
+
+// TupleItems is a Validation that can be used to identify
+// incompatible changes to the number of tuple items of CRD properties.
+type TupleItems struct {
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the TupleItems validation.
+func (ti *TupleItems) Name() string {
+	return tupleItemsValidationName
+}
+
+// SetEnforcement sets the EnforcementPolicy for the TupleItems validation.
+func (ti *TupleItems) SetEnforcement(policy config.EnforcementPolicy) {
+	ti.enforcement = policy
+}
+
+// Compare compares an old and a new JSONSchemaProps, checking for tuple items that were added
+// to a property, resulting in list items at the added positions being validated against a schema.
+// Changes to the schema of a tuple item are evaluated as a property of its own
+// (i.e ^.spec.foo.items[0]) and are not evaluated by this method.
+// In order for callers to determine if diffs to a JSONSchemaProps have been handled by this validation
+// the JSONSchemaProps.Items.JSONSchemas field will be reset to 'nil' as part of this method.
+// It is highly recommended that only copies of the JSONSchemaProps to compare are provided to this method
+// to prevent unintentional modifications.
+func (ti *TupleItems) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.ComparisonResult {
+	var err error
+
+	oldCount, newCount := tupleItemsCount(a), tupleItemsCount(b)
+	if newCount > oldCount {
+		err = fmt.Errorf("%w : %d -> %d : list items at positions %d to %d are now validated against the schemas of the added tuple items", ErrTupleItemsAdded, oldCount, newCount, oldCount, newCount-1)
+	}
+
+	if a.Items != nil {
+		a.Items = &apiextensionsv1.JSONSchemaPropsOrArray{Schema: a.Items.Schema}
+	}
+
+	if b.Items != nil {
+		b.Items = &apiextensionsv1.JSONSchemaPropsOrArray{Schema: b.Items.Schema}
+	}
+
+	return validations.HandleErrors(ti.Name(), ti.enforcement, err)
+}
+
+// tupleItemsCount returns the number of tuple items of the provided JSONSchemaProps.
+func tupleItemsCount(s *apiextensionsv1.JSONSchemaProps) int {
+	if s.Items == nil {
+		return 0
+	}
+
+	return len(s.Items.JSONSchemas)
+}
+
+// ErrTupleItemsAdded represents an error state when tuple items were added to a property.
+var ErrTupleItemsAdded = errors.New("tuple items added")
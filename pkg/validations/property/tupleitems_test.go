@@ -0,0 +1,125 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"slices"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/crdify/pkg/config"
+	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
+)
+
+func TestTupleItems(t *testing.T) {
+	testcases := []internaltesting.Testcase[apiextensionsv1.JSONSchemaProps]{
+		{
+			Name: "no diff, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:  "array",
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{JSONSchemas: []apiextensionsv1.JSONSchemaProps{{Type: "string"}}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:  "array",
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{JSONSchemas: []apiextensionsv1.JSONSchemaProps{{Type: "string"}}},
+			},
+			Flagged:              false,
+			ComparableValidation: &TupleItems{},
+		},
+		{
+			Name: "tuple item added, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:  "array",
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{JSONSchemas: []apiextensionsv1.JSONSchemaProps{{Type: "string"}}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:  "array",
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{JSONSchemas: []apiextensionsv1.JSONSchemaProps{{Type: "string"}, {Type: "integer"}}},
+			},
+			Flagged:              true,
+			ComparableValidation: &TupleItems{},
+		},
+		{
+			Name: "list items schema to tuple items, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:  "array",
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:  "array",
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{JSONSchemas: []apiextensionsv1.JSONSchemaProps{{Type: "string"}}},
+			},
+			Flagged:              true,
+			ComparableValidation: &TupleItems{},
+		},
+		{
+			Name: "tuple item removed, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:  "array",
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{JSONSchemas: []apiextensionsv1.JSONSchemaProps{{Type: "string"}, {Type: "integer"}}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:  "array",
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{JSONSchemas: []apiextensionsv1.JSONSchemaProps{{Type: "string"}}},
+			},
+			Flagged:              false,
+			ComparableValidation: &TupleItems{},
+		},
+		{
+			Name: "tuple item schema changed, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:  "array",
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{JSONSchemas: []apiextensionsv1.JSONSchemaProps{{Type: "string"}}},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:  "array",
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{JSONSchemas: []apiextensionsv1.JSONSchemaProps{{Type: "integer"}}},
+			},
+			Flagged:              false,
+			ComparableValidation: &TupleItems{},
+		},
+		{
+			Name: "different field changed, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				ID: "foo",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				ID: "bar",
+			},
+			Flagged:              false,
+			ComparableValidation: &TupleItems{},
+		},
+	}
+
+	internaltesting.RunTestcases(t, testcases...)
+}
+
+func TestTupleItemsAddedPositions(t *testing.T) {
+	val := &TupleItems{}
+	val.SetEnforcement(config.EnforcementPolicyError)
+
+	result := val.Compare(
+		&apiextensionsv1.JSONSchemaProps{Type: "array", Items: &apiextensionsv1.JSONSchemaPropsOrArray{JSONSchemas: []apiextensionsv1.JSONSchemaProps{{Type: "string"}}}},
+		&apiextensionsv1.JSONSchemaProps{Type: "array", Items: &apiextensionsv1.JSONSchemaPropsOrArray{JSONSchemas: []apiextensionsv1.JSONSchemaProps{{Type: "string"}, {Type: "integer"}, {Type: "boolean"}}}},
+	)
+
+	expected := []string{
+		"tuple items added : 1 -> 3 : list items at positions 1 to 2 are now validated against the schemas of the added tuple items",
+	}
+
+	if !slices.Equal(result.Errors, expected) {
+		t.Fatalf("expected errors %q, got %q", expected, result.Errors)
+	}
+}
@@ -17,6 +17,7 @@ package property
 import (
 	"errors"
 	"fmt"
+	"slices"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"sigs.k8s.io/crdify/pkg/config"
@@ -38,13 +39,70 @@ func RegisterType(registry validations.Registry) {
 
 // typeFactory is a function used to initialize a Type validation
 // implementation based on the provided configuration.
-func typeFactory(_ map[string]interface{}) (validations.Validation, error) {
-	return &Type{}, nil
+func typeFactory(cfg map[string]interface{}) (validations.Validation, error) {
+	typeCfg := &TypeConfig{}
+
+	err := ConfigToType(cfg, typeCfg)
+	if err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	err = ValidateTypeConfig(typeCfg)
+	if err != nil {
+		return nil, fmt.Errorf("validating type config: %w", err)
+	}
+
+	return &Type{TypeConfig: *typeCfg}, nil
+}
+
+// typeIntOrString is the type used in TypeTransitions to represent a
+// property with x-kubernetes-int-or-string set.
+const typeIntOrString = "int-or-string"
+
+// validTypes are the types that can be used in TypeTransitions.
+// An empty type represents an untyped property.
+var validTypes = []string{"", "array", "boolean", "integer", "number", "object", "string", typeIntOrString}
+
+// ValidateTypeConfig ensures provided TypeConfig is valid.
+func ValidateTypeConfig(in *TypeConfig) error {
+	if in == nil {
+		return nil
+	}
+
+	for _, transition := range in.AllowedTransitions {
+		for _, typ := range []string{transition.From, transition.To} {
+			if !slices.Contains(validTypes, typ) {
+				return fmt.Errorf("%w : %q (valid values: %q)", errUnknownType, typ, validTypes)
+			}
+		}
+	}
+
+	return nil
+}
+
+var errUnknownType = errors.New("unknown type")
+
+// TypeTransition is a change of the type of a property.
+type TypeTransition struct {
+	// From is the type of the property before the change.
+	From string `json:"from"`
+	// To is the type of the property after the change.
+	To string `json:"to"`
+}
+
+// TypeConfig contains additional configuration for the Type validation.
+type TypeConfig struct {
+	// AllowedTransitions is the set of type changes that are compatible (i.e integer -> number).
+	// A property with x-kubernetes-int-or-string set is represented by the int-or-string type
+	// when the extension is set alongside the type change (i.e integer -> int-or-string).
+	// Defaults to no type changes being compatible.
+	AllowedTransitions []TypeTransition `json:"allowedTransitions,omitempty"`
 }
 
 // Type is a Validation that can be used to identify
 // incompatible changes to the type constraints of CRD properties.
 type Type struct {
+	TypeConfig
 	enforcement config.EnforcementPolicy
 }
 
@@ -59,14 +117,25 @@ func (t *Type) SetEnforcement(policy config.EnforcementPolicy) {
 }
 
 // Compare compares an old and a new JSONSchemaProps, checking for incompatible changes to the type constraints of a property.
+// Type changes that are part of the configured allowed transitions are not flagged.
 // In order for callers to determine if diffs to a JSONSchemaProps have been handled by this validation
-// the JSONSchemaProps.Type field will be reset to '""' as part of this method.
+// the JSONSchemaProps.Type field will be reset to '""' as part of this method. When the type changed,
+// the JSONSchemaProps.XIntOrString field is considered part of the type and is also reset to 'false'.
 // It is highly recommended that only copies of the JSONSchemaProps to compare are provided to this method
 // to prevent unintentional modifications.
 func (t *Type) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.ComparisonResult {
 	var err error
+
 	if a.Type != b.Type {
-		err = fmt.Errorf("%w : %q -> %q", ErrTypeChanged, a.Type, b.Type)
+		from, to := effectiveType(a), effectiveType(b)
+		if from != to && !slices.Contains(t.AllowedTransitions, TypeTransition{From: from, To: to}) {
+			err = fmt.Errorf("%w : %q -> %q", ErrTypeChanged, from, to)
+		}
+
+		// x-kubernetes-int-or-string is part of the type change,
+		// so it is not evaluated by the xIntOrString validation
+		a.XIntOrString = false
+		b.XIntOrString = false
 	}
 
 	a.Type = ""
@@ -75,5 +144,15 @@ func (t *Type) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.Compar
 	return validations.HandleErrors(t.Name(), t.enforcement, err)
 }
 
+// effectiveType returns the type of a property, taking
+// x-kubernetes-int-or-string into account.
+func effectiveType(s *apiextensionsv1.JSONSchemaProps) string {
+	if s.XIntOrString {
+		return typeIntOrString
+	}
+
+	return s.Type
+}
+
 // ErrTypeChanged represents an error state when a property type changed.
 var ErrTypeChanged = errors.New("type changed")
@@ -15,6 +15,7 @@
 package property
 
 import (
+	"errors"
 	"testing"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -45,6 +46,90 @@ func TestType(t *testing.T) {
 			Flagged:              true,
 			ComparableValidation: &Type{},
 		},
+		{
+			Name: "integer widened to number, flagged by default",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type: "integer",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type: "number",
+			},
+			Flagged:              true,
+			ComparableValidation: &Type{},
+		},
+		{
+			Name: "integer widened to number, allowed via config",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type: "integer",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type: "number",
+			},
+			Flagged: false,
+			ComparableValidation: &Type{
+				TypeConfig: TypeConfig{AllowedTransitions: []TypeTransition{{From: "integer", To: "number"}}},
+			},
+		},
+		{
+			Name: "number narrowed to integer, flagged when only widening is allowed",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type: "number",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type: "integer",
+			},
+			Flagged: true,
+			ComparableValidation: &Type{
+				TypeConfig: TypeConfig{AllowedTransitions: []TypeTransition{{From: "integer", To: "number"}}},
+			},
+		},
+		{
+			Name: "integer changed to int-or-string, flagged by default",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type: "integer",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				XIntOrString: true,
+			},
+			Flagged:              true,
+			ComparableValidation: &Type{},
+		},
+		{
+			Name: "integer changed to int-or-string, allowed via config",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type: "integer",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				XIntOrString: true,
+			},
+			Flagged: false,
+			ComparableValidation: &Type{
+				TypeConfig: TypeConfig{AllowedTransitions: []TypeTransition{{From: "integer", To: "int-or-string"}}},
+			},
+		},
+		{
+			Name: "integer changed to untyped, flagged when only int-or-string is allowed",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type: "integer",
+			},
+			New:     &apiextensionsv1.JSONSchemaProps{},
+			Flagged: true,
+			ComparableValidation: &Type{
+				TypeConfig: TypeConfig{AllowedTransitions: []TypeTransition{{From: "integer", To: "int-or-string"}}},
+			},
+		},
+		{
+			Name: "int-or-string set without a type change, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type: "integer",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:         "integer",
+				XIntOrString: true,
+			},
+			Flagged:              false,
+			ComparableValidation: &Type{},
+		},
 		{
 			Name: "different field changed, not flagged",
 			Old: &apiextensionsv1.JSONSchemaProps{
@@ -60,3 +145,46 @@ func TestType(t *testing.T) {
 
 	internaltesting.RunTestcases(t, testcases...)
 }
+
+func TestValidateTypeConfig(t *testing.T) {
+	testcases := []struct {
+		name    string
+		cfg     *TypeConfig
+		wantErr error
+	}{
+		{
+			name: "nil config",
+			cfg:  nil,
+		},
+		{
+			name: "empty config",
+			cfg:  &TypeConfig{},
+		},
+		{
+			name: "valid transitions",
+			cfg: &TypeConfig{AllowedTransitions: []TypeTransition{
+				{From: "integer", To: "number"},
+				{From: "string", To: "int-or-string"},
+			}},
+		},
+		{
+			name:    "unknown from type mentions valid values",
+			cfg:     &TypeConfig{AllowedTransitions: []TypeTransition{{From: "int", To: "number"}}},
+			wantErr: errUnknownType,
+		},
+		{
+			name:    "unknown to type mentions valid values",
+			cfg:     &TypeConfig{AllowedTransitions: []TypeTransition{{From: "integer", To: "float"}}},
+			wantErr: errUnknownType,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateTypeConfig(tc.cfg)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+)
+
+var (
+	_ validations.Validation                                  = (*UniqueItems)(nil)
+	_ validations.Comparator[apiextensionsv1.JSONSchemaProps] = (*UniqueItems)(nil)
+)
+
+const uniqueItemsValidationName = "uniqueItems"
+
+// RegisterUniqueItems registers the UniqueItems validation
+// with the provided validation registry.
+func RegisterUniqueItems(registry validations.Registry) {
+	registry.Register(uniqueItemsValidationName, uniqueItemsFactory)
+}
+
+// uniqueItemsFactory is a function used to initialize a UniqueItems validation
+// implementation based on the provided configuration.
+func uniqueItemsFactory(cfg map[string]interface{}) (validations.Validation, error) {
+	uniqueItemsCfg := &UniqueItemsConfig{}
+
+	err := ConfigToType(cfg, uniqueItemsCfg)
+	if err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	err = ValidateUniqueItemsConfig(uniqueItemsCfg)
+	if err != nil {
+		return nil, fmt.Errorf("validating uniqueItems config: %w", err)
+	}
+
+	return &UniqueItems{UniqueItemsConfig: *uniqueItemsCfg}, nil
+}
+
+// ValidateUniqueItemsConfig ensures provided UniqueItemsConfig is valid and defaults missing values.
+func ValidateUniqueItemsConfig(in *UniqueItemsConfig) error {
+	if in == nil {
+		return nil
+	}
+
+	switch in.AdditionPolicy {
+	case UniqueItemsAdditionPolicyAllow, UniqueItemsAdditionPolicyDisallow:
+		// valid entries
+	case UniqueItemsAdditionPolicy(""):
+		in.AdditionPolicy = UniqueItemsAdditionPolicyDisallow
+	default:
+		return fmt.Errorf("%w : %q (valid values: %q, %q)", errUnknownUniqueItemsAdditionPolicy, in.AdditionPolicy, UniqueItemsAdditionPolicyAllow, UniqueItemsAdditionPolicyDisallow)
+	}
+
+	switch in.RemovalPolicy {
+	case UniqueItemsRemovalPolicyAllow, UniqueItemsRemovalPolicyDisallow:
+		// valid entries
+	case UniqueItemsRemovalPolicy(""):
+		in.RemovalPolicy = UniqueItemsRemovalPolicyAllow
+	default:
+		return fmt.Errorf("%w : %q (valid values: %q, %q)", errUnknownUniqueItemsRemovalPolicy, in.RemovalPolicy, UniqueItemsRemovalPolicyAllow, UniqueItemsRemovalPolicyDisallow)
+	}
+
+	return nil
+}
+
+var (
+	errUnknownUniqueItemsAdditionPolicy = errors.New("unknown addition policy")
+	errUnknownUniqueItemsRemovalPolicy  = errors.New("unknown removal policy")
+)
+
+// UniqueItemsAdditionPolicy represents how requiring unique items should be evaluated.
+type UniqueItemsAdditionPolicy string
+
+const (
+	// UniqueItemsAdditionPolicyAllow treats requiring unique items as compatible.
+	UniqueItemsAdditionPolicyAllow UniqueItemsAdditionPolicy = "Allow"
+	// UniqueItemsAdditionPolicyDisallow treats requiring unique items as incompatible.
+	UniqueItemsAdditionPolicyDisallow UniqueItemsAdditionPolicy = "Disallow"
+)
+
+// UniqueItemsRemovalPolicy represents how no longer requiring unique items should be evaluated.
+type UniqueItemsRemovalPolicy string
+
+const (
+	// UniqueItemsRemovalPolicyAllow treats no longer requiring unique items as compatible.
+	UniqueItemsRemovalPolicyAllow UniqueItemsRemovalPolicy = "Allow"
+	// UniqueItemsRemovalPolicyDisallow treats no longer requiring unique items as incompatible.
+	UniqueItemsRemovalPolicyDisallow UniqueItemsRemovalPolicy = "Disallow"
+)
+
+// UniqueItemsConfig contains additional configuration for the UniqueItems validation.
+type UniqueItemsConfig struct {
+	// AdditionPolicy dictates whether requiring unique items is compatible.
+	// Allowed values are Allow and Disallow. Defaults to Disallow.
+	AdditionPolicy UniqueItemsAdditionPolicy `json:"additionPolicy,omitempty"`
+	// RemovalPolicy dictates whether no longer requiring unique items is compatible.
+	// Allowed values are Allow and Disallow. Defaults to Allow.
+	RemovalPolicy UniqueItemsRemovalPolicy `json:"removalPolicy,omitempty"`
+}
+
+// UniqueItems is a Validation that can be used to identify
+// incompatible changes to the uniqueItems constraint of CRD properties.
+type UniqueItems struct {
+	UniqueItemsConfig
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the UniqueItems validation.
+func (u *UniqueItems) Name() string {
+	return uniqueItemsValidationName
+}
+
+// SetEnforcement sets the EnforcementPolicy for the UniqueItems validation.
+func (u *UniqueItems) SetEnforcement(policy config.EnforcementPolicy) {
+	u.enforcement = policy
+}
+
+// Compare compares an old and a new JSONSchemaProps, checking for changes to the uniqueItems constraint of a property.
+// In order for callers to determine if diffs to a JSONSchemaProps have been handled by this validation
+// the JSONSchemaProps.UniqueItems field will be reset to 'false' as part of this method.
+// It is highly recommended that only copies of the JSONSchemaProps to compare are provided to this method
+// to prevent unintentional modifications.
+func (u *UniqueItems) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.ComparisonResult {
+	var err error
+
+	switch {
+	case a.UniqueItems == b.UniqueItems:
+		// nothing to do
+	case !a.UniqueItems && b.UniqueItems && u.AdditionPolicy != UniqueItemsAdditionPolicyAllow:
+		err = fmt.Errorf("%w : %t -> %t", ErrUniqueItemsAdded, a.UniqueItems, b.UniqueItems)
+	case a.UniqueItems && !b.UniqueItems && u.RemovalPolicy == UniqueItemsRemovalPolicyDisallow:
+		err = fmt.Errorf("%w : %t -> %t", ErrUniqueItemsRemoved, a.UniqueItems, b.UniqueItems)
+	}
+
+	a.UniqueItems = false
+	b.UniqueItems = false
+
+	return validations.HandleErrors(u.Name(), u.enforcement, err)
+}
+
+// ErrUniqueItemsAdded represents an error state when a property starts requiring unique items.
+var ErrUniqueItemsAdded = errors.New("unique items constraint added")
+
+// ErrUniqueItemsRemoved represents an error state when a property stops requiring unique items.
+var ErrUniqueItemsRemoved = errors.New("unique items constraint removed")
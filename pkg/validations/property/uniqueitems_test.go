@@ -0,0 +1,162 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
+)
+
+func TestUniqueItems(t *testing.T) {
+	testcases := []internaltesting.Testcase[apiextensionsv1.JSONSchemaProps]{
+		{
+			Name: "no diff, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				UniqueItems: true,
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				UniqueItems: true,
+			},
+			Flagged:              false,
+			ComparableValidation: &UniqueItems{},
+		},
+		{
+			Name: "unique items added, flagged by default",
+			Old:  &apiextensionsv1.JSONSchemaProps{Type: "array"},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:        "array",
+				UniqueItems: true,
+			},
+			Flagged:              true,
+			ComparableValidation: &UniqueItems{},
+		},
+		{
+			Name: "unique items added, allowed via config",
+			Old:  &apiextensionsv1.JSONSchemaProps{Type: "array"},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:        "array",
+				UniqueItems: true,
+			},
+			Flagged: false,
+			ComparableValidation: &UniqueItems{
+				UniqueItemsConfig: UniqueItemsConfig{AdditionPolicy: UniqueItemsAdditionPolicyAllow},
+			},
+		},
+		{
+			Name: "unique items removed, not flagged by default",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:        "array",
+				UniqueItems: true,
+			},
+			New:                  &apiextensionsv1.JSONSchemaProps{Type: "array"},
+			Flagged:              false,
+			ComparableValidation: &UniqueItems{},
+		},
+		{
+			Name: "unique items removed, disallowed via config",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:        "array",
+				UniqueItems: true,
+			},
+			New:     &apiextensionsv1.JSONSchemaProps{Type: "array"},
+			Flagged: true,
+			ComparableValidation: &UniqueItems{
+				UniqueItemsConfig: UniqueItemsConfig{RemovalPolicy: UniqueItemsRemovalPolicyDisallow},
+			},
+		},
+		{
+			Name: "different field changed, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				ID: "foo",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				ID: "bar",
+			},
+			Flagged:              false,
+			ComparableValidation: &UniqueItems{},
+		},
+	}
+
+	internaltesting.RunTestcases(t, testcases...)
+}
+
+func TestValidateUniqueItemsConfig(t *testing.T) {
+	testcases := []struct {
+		name               string
+		cfg                *UniqueItemsConfig
+		wantErr            error
+		wantAdditionPolicy UniqueItemsAdditionPolicy
+		wantRemovalPolicy  UniqueItemsRemovalPolicy
+	}{
+		{
+			name: "nil config",
+			cfg:  nil,
+		},
+		{
+			name:               "defaults policies",
+			cfg:                &UniqueItemsConfig{},
+			wantAdditionPolicy: UniqueItemsAdditionPolicyDisallow,
+			wantRemovalPolicy:  UniqueItemsRemovalPolicyAllow,
+		},
+		{
+			name:               "allows valid policies",
+			cfg:                &UniqueItemsConfig{AdditionPolicy: UniqueItemsAdditionPolicyAllow, RemovalPolicy: UniqueItemsRemovalPolicyDisallow},
+			wantAdditionPolicy: UniqueItemsAdditionPolicyAllow,
+			wantRemovalPolicy:  UniqueItemsRemovalPolicyDisallow,
+		},
+		{
+			name:    "invalid addition policy",
+			cfg:     &UniqueItemsConfig{AdditionPolicy: "invalid"},
+			wantErr: errUnknownUniqueItemsAdditionPolicy,
+		},
+		{
+			name:    "invalid removal policy",
+			cfg:     &UniqueItemsConfig{RemovalPolicy: "invalid"},
+			wantErr: errUnknownUniqueItemsRemovalPolicy,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateUniqueItemsConfig(tc.cfg)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tc.cfg == nil {
+				return
+			}
+
+			if tc.cfg.AdditionPolicy != tc.wantAdditionPolicy {
+				t.Fatalf("expected addition policy %q, got %q", tc.wantAdditionPolicy, tc.cfg.AdditionPolicy)
+			}
+
+			if tc.cfg.RemovalPolicy != tc.wantRemovalPolicy {
+				t.Fatalf("expected removal policy %q, got %q", tc.wantRemovalPolicy, tc.cfg.RemovalPolicy)
+			}
+		})
+	}
+}
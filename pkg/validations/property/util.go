@@ -34,3 +34,29 @@ func ConfigToType[T any](in map[string]interface{}, out *T) error {
 
 	return nil
 }
+
+// flagChange describes how a change of a boolean extension of a property in one direction is evaluated.
+type flagChange struct {
+	// allowed is whether the change is compatible.
+	allowed bool
+	// err is the error reported when the change is not allowed.
+	err error
+	// impact explains the effect of the change in the reported error.
+	impact string
+}
+
+// compareFlag compares an old and a new boolean extension of a property.
+// set is used to evaluate the extension being enabled and unset to evaluate it being disabled.
+// Returns an error when the change is not allowed.
+func compareFlag(oldValue, newValue bool, set, unset flagChange) error {
+	change := set
+	if oldValue {
+		change = unset
+	}
+
+	if oldValue == newValue || change.allowed {
+		return nil
+	}
+
+	return fmt.Errorf("%w : %t -> %t : %s", change.err, oldValue, newValue, change.impact)
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+)
+
+var (
+	_ validations.Validation                                  = (*XEmbeddedResource)(nil)
+	_ validations.Comparator[apiextensionsv1.JSONSchemaProps] = (*XEmbeddedResource)(nil)
+)
+
+const xEmbeddedResourceValidationName = "xEmbeddedResource"
+
+// RegisterXEmbeddedResource registers the XEmbeddedResource validation
+// with the provided validation registry.
+func RegisterXEmbeddedResource(registry validations.Registry) {
+	registry.Register(xEmbeddedResourceValidationName, xEmbeddedResourceFactory)
+}
+
+// xEmbeddedResourceFactory is a function used to initialize a XEmbeddedResource validation
+// implementation based on the provided configuration.
+func xEmbeddedResourceFactory(cfg map[string]interface{}) (validations.Validation, error) {
+	xEmbeddedResourceCfg := &XEmbeddedResourceConfig{}
+
+	err := ConfigToType(cfg, xEmbeddedResourceCfg)
+	if err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	err = ValidateXEmbeddedResourceConfig(xEmbeddedResourceCfg)
+	if err != nil {
+		return nil, fmt.Errorf("validating xEmbeddedResource config: %w", err)
+	}
+
+	return &XEmbeddedResource{XEmbeddedResourceConfig: *xEmbeddedResourceCfg}, nil
+}
+
+// ValidateXEmbeddedResourceConfig ensures provided XEmbeddedResourceConfig is valid and defaults missing values.
+func ValidateXEmbeddedResourceConfig(in *XEmbeddedResourceConfig) error {
+	if in == nil {
+		return nil
+	}
+
+	switch in.AdditionPolicy {
+	case XEmbeddedResourceAdditionPolicyAllow, XEmbeddedResourceAdditionPolicyDisallow:
+		// valid entries
+	case XEmbeddedResourceAdditionPolicy(""):
+		in.AdditionPolicy = XEmbeddedResourceAdditionPolicyDisallow
+	default:
+		return fmt.Errorf("%w : %q (valid values: %q, %q)", errUnknownXEmbeddedResourceAdditionPolicy, in.AdditionPolicy, XEmbeddedResourceAdditionPolicyAllow, XEmbeddedResourceAdditionPolicyDisallow)
+	}
+
+	switch in.RemovalPolicy {
+	case XEmbeddedResourceRemovalPolicyAllow, XEmbeddedResourceRemovalPolicyDisallow:
+		// valid entries
+	case XEmbeddedResourceRemovalPolicy(""):
+		in.RemovalPolicy = XEmbeddedResourceRemovalPolicyDisallow
+	default:
+		return fmt.Errorf("%w : %q (valid values: %q, %q)", errUnknownXEmbeddedResourceRemovalPolicy, in.RemovalPolicy, XEmbeddedResourceRemovalPolicyAllow, XEmbeddedResourceRemovalPolicyDisallow)
+	}
+
+	return nil
+}
+
+var (
+	errUnknownXEmbeddedResourceAdditionPolicy = errors.New("unknown addition policy")
+	errUnknownXEmbeddedResourceRemovalPolicy  = errors.New("unknown removal policy")
+)
+
+// XEmbeddedResourceAdditionPolicy represents how marking a property as an embedded resource should be evaluated.
+type XEmbeddedResourceAdditionPolicy string
+
+const (
+	// XEmbeddedResourceAdditionPolicyAllow treats marking a property as an embedded resource as compatible.
+	XEmbeddedResourceAdditionPolicyAllow XEmbeddedResourceAdditionPolicy = "Allow"
+	// XEmbeddedResourceAdditionPolicyDisallow treats marking a property as an embedded resource as incompatible.
+	XEmbeddedResourceAdditionPolicyDisallow XEmbeddedResourceAdditionPolicy = "Disallow"
+)
+
+// XEmbeddedResourceRemovalPolicy represents how no longer marking a property as an embedded resource should be evaluated.
+type XEmbeddedResourceRemovalPolicy string
+
+const (
+	// XEmbeddedResourceRemovalPolicyAllow treats no longer marking a property as an embedded resource as compatible.
+	XEmbeddedResourceRemovalPolicyAllow XEmbeddedResourceRemovalPolicy = "Allow"
+	// XEmbeddedResourceRemovalPolicyDisallow treats no longer marking a property as an embedded resource as incompatible.
+	XEmbeddedResourceRemovalPolicyDisallow XEmbeddedResourceRemovalPolicy = "Disallow"
+)
+
+// XEmbeddedResourceConfig contains additional configuration for the XEmbeddedResource validation.
+type XEmbeddedResourceConfig struct {
+	// AdditionPolicy dictates whether marking a property as an embedded resource is compatible.
+	// Allowed values are Allow and Disallow. Defaults to Disallow.
+	AdditionPolicy XEmbeddedResourceAdditionPolicy `json:"additionPolicy,omitempty"`
+	// RemovalPolicy dictates whether no longer marking a property as an embedded resource is compatible.
+	// Allowed values are Allow and Disallow. Defaults to Disallow.
+	RemovalPolicy XEmbeddedResourceRemovalPolicy `json:"removalPolicy,omitempty"`
+}
+
+// XEmbeddedResource is a Validation that can be used to identify
+// incompatible changes to the x-kubernetes-embedded-resource setting of CRD properties.
+type XEmbeddedResource struct {
+	XEmbeddedResourceConfig
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the XEmbeddedResource validation.
+func (x *XEmbeddedResource) Name() string {
+	return xEmbeddedResourceValidationName
+}
+
+// SetEnforcement sets the EnforcementPolicy for the XEmbeddedResource validation.
+func (x *XEmbeddedResource) SetEnforcement(policy config.EnforcementPolicy) {
+	x.enforcement = policy
+}
+
+// Compare compares an old and a new JSONSchemaProps, checking for changes to the x-kubernetes-embedded-resource setting of a property.
+// In order for callers to determine if diffs to a JSONSchemaProps have been handled by this validation
+// the JSONSchemaProps.XEmbeddedResource field will be reset to 'false' as part of this method.
+// It is highly recommended that only copies of the JSONSchemaProps to compare are provided to this method
+// to prevent unintentional modifications.
+func (x *XEmbeddedResource) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.ComparisonResult {
+	err := compareFlag(a.XEmbeddedResource, b.XEmbeddedResource,
+		flagChange{
+			allowed: x.AdditionPolicy == XEmbeddedResourceAdditionPolicyAllow,
+			err:     ErrEmbeddedResourceAdded,
+			impact:  "the apiVersion, kind and metadata of the embedded object are now validated and metadata is pruned",
+		},
+		flagChange{
+			allowed: x.RemovalPolicy == XEmbeddedResourceRemovalPolicyAllow,
+			err:     ErrEmbeddedResourceRemoved,
+			impact:  "the apiVersion, kind and metadata of the embedded object are no longer validated",
+		},
+	)
+
+	a.XEmbeddedResource = false
+	b.XEmbeddedResource = false
+
+	return validations.HandleErrors(x.Name(), x.enforcement, err)
+}
+
+// ErrEmbeddedResourceAdded represents an error state when a property is marked as an embedded resource.
+var ErrEmbeddedResourceAdded = errors.New("embedded resource added")
+
+// ErrEmbeddedResourceRemoved represents an error state when a property is no longer marked as an embedded resource.
+var ErrEmbeddedResourceRemoved = errors.New("embedded resource removed")
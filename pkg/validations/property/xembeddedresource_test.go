@@ -0,0 +1,174 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
+)
+
+func TestXEmbeddedResource(t *testing.T) {
+	testcases := []internaltesting.Testcase[apiextensionsv1.JSONSchemaProps]{
+		{
+			Name: "no diff, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				XEmbeddedResource: true,
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				XEmbeddedResource: true,
+			},
+			Flagged:              false,
+			ComparableValidation: &XEmbeddedResource{},
+		},
+		{
+			Name: "embedded resource added, flagged by default",
+			Old:  &apiextensionsv1.JSONSchemaProps{Type: "object"},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:              "object",
+				XEmbeddedResource: true,
+			},
+			Flagged:              true,
+			ComparableValidation: &XEmbeddedResource{},
+		},
+		{
+			Name: "embedded resource added, allowed via config",
+			Old:  &apiextensionsv1.JSONSchemaProps{Type: "object"},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:              "object",
+				XEmbeddedResource: true,
+			},
+			Flagged: false,
+			ComparableValidation: &XEmbeddedResource{
+				XEmbeddedResourceConfig: XEmbeddedResourceConfig{AdditionPolicy: XEmbeddedResourceAdditionPolicyAllow},
+			},
+		},
+		{
+			Name: "embedded resource removed, flagged by default",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:              "object",
+				XEmbeddedResource: true,
+			},
+			New:                  &apiextensionsv1.JSONSchemaProps{Type: "object"},
+			Flagged:              true,
+			ComparableValidation: &XEmbeddedResource{},
+		},
+		{
+			Name: "embedded resource removed, allowed via config",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:              "object",
+				XEmbeddedResource: true,
+			},
+			New:     &apiextensionsv1.JSONSchemaProps{Type: "object"},
+			Flagged: false,
+			ComparableValidation: &XEmbeddedResource{
+				XEmbeddedResourceConfig: XEmbeddedResourceConfig{RemovalPolicy: XEmbeddedResourceRemovalPolicyAllow},
+			},
+		},
+		{
+			Name: "embedded resource removed, flagged even when additions are allowed",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:              "object",
+				XEmbeddedResource: true,
+			},
+			New:     &apiextensionsv1.JSONSchemaProps{Type: "object"},
+			Flagged: true,
+			ComparableValidation: &XEmbeddedResource{
+				XEmbeddedResourceConfig: XEmbeddedResourceConfig{AdditionPolicy: XEmbeddedResourceAdditionPolicyAllow},
+			},
+		},
+		{
+			Name: "different field changed, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				ID: "foo",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				ID: "bar",
+			},
+			Flagged:              false,
+			ComparableValidation: &XEmbeddedResource{},
+		},
+	}
+
+	internaltesting.RunTestcases(t, testcases...)
+}
+
+func TestValidateXEmbeddedResourceConfig(t *testing.T) {
+	testcases := []struct {
+		name               string
+		cfg                *XEmbeddedResourceConfig
+		wantErr            error
+		wantAdditionPolicy XEmbeddedResourceAdditionPolicy
+		wantRemovalPolicy  XEmbeddedResourceRemovalPolicy
+	}{
+		{
+			name: "nil config",
+			cfg:  nil,
+		},
+		{
+			name:               "defaults policies",
+			cfg:                &XEmbeddedResourceConfig{},
+			wantAdditionPolicy: XEmbeddedResourceAdditionPolicyDisallow,
+			wantRemovalPolicy:  XEmbeddedResourceRemovalPolicyDisallow,
+		},
+		{
+			name:               "allows valid policies",
+			cfg:                &XEmbeddedResourceConfig{AdditionPolicy: XEmbeddedResourceAdditionPolicyAllow, RemovalPolicy: XEmbeddedResourceRemovalPolicyAllow},
+			wantAdditionPolicy: XEmbeddedResourceAdditionPolicyAllow,
+			wantRemovalPolicy:  XEmbeddedResourceRemovalPolicyAllow,
+		},
+		{
+			name:    "invalid addition policy",
+			cfg:     &XEmbeddedResourceConfig{AdditionPolicy: "invalid"},
+			wantErr: errUnknownXEmbeddedResourceAdditionPolicy,
+		},
+		{
+			name:    "invalid removal policy",
+			cfg:     &XEmbeddedResourceConfig{RemovalPolicy: "invalid"},
+			wantErr: errUnknownXEmbeddedResourceRemovalPolicy,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateXEmbeddedResourceConfig(tc.cfg)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tc.cfg == nil {
+				return
+			}
+
+			if tc.cfg.AdditionPolicy != tc.wantAdditionPolicy {
+				t.Fatalf("expected addition policy %q, got %q", tc.wantAdditionPolicy, tc.cfg.AdditionPolicy)
+			}
+
+			if tc.cfg.RemovalPolicy != tc.wantRemovalPolicy {
+				t.Fatalf("expected removal policy %q, got %q", tc.wantRemovalPolicy, tc.cfg.RemovalPolicy)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+)
+
+var (
+	_ validations.Validation                                  = (*XIntOrString)(nil)
+	_ validations.Comparator[apiextensionsv1.JSONSchemaProps] = (*XIntOrString)(nil)
+)
+
+const xIntOrStringValidationName = "xIntOrString"
+
+// RegisterXIntOrString registers the XIntOrString validation
+// with the provided validation registry.
+func RegisterXIntOrString(registry validations.Registry) {
+	registry.Register(xIntOrStringValidationName, xIntOrStringFactory)
+}
+
+// xIntOrStringFactory is a function used to initialize a XIntOrString validation
+// implementation based on the provided configuration.
+func xIntOrStringFactory(cfg map[string]interface{}) (validations.Validation, error) {
+	xIntOrStringCfg := &XIntOrStringConfig{}
+
+	err := ConfigToType(cfg, xIntOrStringCfg)
+	if err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	err = ValidateXIntOrStringConfig(xIntOrStringCfg)
+	if err != nil {
+		return nil, fmt.Errorf("validating xIntOrString config: %w", err)
+	}
+
+	return &XIntOrString{XIntOrStringConfig: *xIntOrStringCfg}, nil
+}
+
+// ValidateXIntOrStringConfig ensures provided XIntOrStringConfig is valid and defaults missing values.
+func ValidateXIntOrStringConfig(in *XIntOrStringConfig) error {
+	if in == nil {
+		return nil
+	}
+
+	switch in.AdditionPolicy {
+	case XIntOrStringAdditionPolicyAllow, XIntOrStringAdditionPolicyDisallow:
+		// valid entries
+	case XIntOrStringAdditionPolicy(""):
+		in.AdditionPolicy = XIntOrStringAdditionPolicyDisallow
+	default:
+		return fmt.Errorf("%w : %q (valid values: %q, %q)", errUnknownXIntOrStringAdditionPolicy, in.AdditionPolicy, XIntOrStringAdditionPolicyAllow, XIntOrStringAdditionPolicyDisallow)
+	}
+
+	switch in.RemovalPolicy {
+	case XIntOrStringRemovalPolicyAllow, XIntOrStringRemovalPolicyDisallow:
+		// valid entries
+	case XIntOrStringRemovalPolicy(""):
+		in.RemovalPolicy = XIntOrStringRemovalPolicyDisallow
+	default:
+		return fmt.Errorf("%w : %q (valid values: %q, %q)", errUnknownXIntOrStringRemovalPolicy, in.RemovalPolicy, XIntOrStringRemovalPolicyAllow, XIntOrStringRemovalPolicyDisallow)
+	}
+
+	return nil
+}
+
+var (
+	errUnknownXIntOrStringAdditionPolicy = errors.New("unknown addition policy")
+	errUnknownXIntOrStringRemovalPolicy  = errors.New("unknown removal policy")
+)
+
+// XIntOrStringAdditionPolicy represents how allowing both integers and strings should be evaluated.
+type XIntOrStringAdditionPolicy string
+
+const (
+	// XIntOrStringAdditionPolicyAllow treats allowing both integers and strings as compatible.
+	XIntOrStringAdditionPolicyAllow XIntOrStringAdditionPolicy = "Allow"
+	// XIntOrStringAdditionPolicyDisallow treats allowing both integers and strings as incompatible.
+	XIntOrStringAdditionPolicyDisallow XIntOrStringAdditionPolicy = "Disallow"
+)
+
+// XIntOrStringRemovalPolicy represents how no longer allowing both integers and strings should be evaluated.
+type XIntOrStringRemovalPolicy string
+
+const (
+	// XIntOrStringRemovalPolicyAllow treats no longer allowing both integers and strings as compatible.
+	XIntOrStringRemovalPolicyAllow XIntOrStringRemovalPolicy = "Allow"
+	// XIntOrStringRemovalPolicyDisallow treats no longer allowing both integers and strings as incompatible.
+	XIntOrStringRemovalPolicyDisallow XIntOrStringRemovalPolicy = "Disallow"
+)
+
+// XIntOrStringConfig contains additional configuration for the XIntOrString validation.
+type XIntOrStringConfig struct {
+	// AdditionPolicy dictates whether allowing both integers and strings is compatible.
+	// Allowed values are Allow and Disallow. Defaults to Disallow.
+	AdditionPolicy XIntOrStringAdditionPolicy `json:"additionPolicy,omitempty"`
+	// RemovalPolicy dictates whether no longer allowing both integers and strings is compatible.
+	// Allowed values are Allow and Disallow. Defaults to Disallow.
+	RemovalPolicy XIntOrStringRemovalPolicy `json:"removalPolicy,omitempty"`
+}
+
+// XIntOrString is a Validation that can be used to identify
+// incompatible changes to the x-kubernetes-int-or-string setting of CRD properties.
+type XIntOrString struct {
+	XIntOrStringConfig
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the XIntOrString validation.
+func (x *XIntOrString) Name() string {
+	return xIntOrStringValidationName
+}
+
+// SetEnforcement sets the EnforcementPolicy for the XIntOrString validation.
+func (x *XIntOrString) SetEnforcement(policy config.EnforcementPolicy) {
+	x.enforcement = policy
+}
+
+// Compare compares an old and a new JSONSchemaProps, checking for changes to the x-kubernetes-int-or-string setting of a property.
+// Changes that happen alongside a change of the type of the property are evaluated by the type validation instead.
+// In order for callers to determine if diffs to a JSONSchemaProps have been handled by this validation
+// the JSONSchemaProps.XIntOrString field will be reset to 'false' as part of this method.
+// It is highly recommended that only copies of the JSONSchemaProps to compare are provided to this method
+// to prevent unintentional modifications.
+func (x *XIntOrString) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.ComparisonResult {
+	// a type change alongside x-kubernetes-int-or-string (i.e integer -> int-or-string)
+	// is evaluated, and reset, by the type validation
+	if a.Type != b.Type {
+		return validations.HandleErrors(x.Name(), x.enforcement)
+	}
+
+	err := compareFlag(a.XIntOrString, b.XIntOrString,
+		flagChange{
+			allowed: x.AdditionPolicy == XIntOrStringAdditionPolicyAllow,
+			err:     ErrIntOrStringAdded,
+			impact:  "values of both types are now accepted, widening the accepted values",
+		},
+		flagChange{
+			allowed: x.RemovalPolicy == XIntOrStringRemovalPolicyAllow,
+			err:     ErrIntOrStringRemoved,
+			impact:  "values of one of the types are no longer accepted, narrowing the accepted values",
+		},
+	)
+
+	a.XIntOrString = false
+	b.XIntOrString = false
+
+	return validations.HandleErrors(x.Name(), x.enforcement, err)
+}
+
+// ErrIntOrStringAdded represents an error state when a property starts accepting both integers and strings.
+var ErrIntOrStringAdded = errors.New("int-or-string added")
+
+// ErrIntOrStringRemoved represents an error state when a property stops accepting both integers and strings.
+var ErrIntOrStringRemoved = errors.New("int-or-string removed")
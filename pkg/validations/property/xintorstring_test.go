@@ -0,0 +1,218 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
+)
+
+func TestXIntOrString(t *testing.T) {
+	testcases := []internaltesting.Testcase[apiextensionsv1.JSONSchemaProps]{
+		{
+			Name: "no diff, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				XIntOrString: true,
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				XIntOrString: true,
+			},
+			Flagged:              false,
+			ComparableValidation: &XIntOrString{},
+		},
+		{
+			Name: "int-or-string added to integer field, flagged by default",
+			Old:  &apiextensionsv1.JSONSchemaProps{Type: "integer"},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:         "integer",
+				XIntOrString: true,
+			},
+			Flagged:              true,
+			ComparableValidation: &XIntOrString{},
+		},
+		{
+			Name: "int-or-string added alongside a type change, not flagged",
+			Old:  &apiextensionsv1.JSONSchemaProps{Type: "integer"},
+			New: &apiextensionsv1.JSONSchemaProps{
+				XIntOrString: true,
+			},
+			Flagged:              false,
+			ComparableValidation: &XIntOrString{},
+		},
+		{
+			Name: "int-or-string added to integer field, allowed via config",
+			Old:  &apiextensionsv1.JSONSchemaProps{Type: "integer"},
+			New: &apiextensionsv1.JSONSchemaProps{
+				Type:         "integer",
+				XIntOrString: true,
+			},
+			Flagged: false,
+			ComparableValidation: &XIntOrString{
+				XIntOrStringConfig: XIntOrStringConfig{AdditionPolicy: XIntOrStringAdditionPolicyAllow},
+			},
+		},
+		{
+			Name: "int-or-string removed, flagged by default",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:         "integer",
+				XIntOrString: true,
+			},
+			New:                  &apiextensionsv1.JSONSchemaProps{Type: "integer"},
+			Flagged:              true,
+			ComparableValidation: &XIntOrString{},
+		},
+		{
+			Name: "int-or-string removed, allowed via config",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:         "integer",
+				XIntOrString: true,
+			},
+			New:     &apiextensionsv1.JSONSchemaProps{Type: "integer"},
+			Flagged: false,
+			ComparableValidation: &XIntOrString{
+				XIntOrStringConfig: XIntOrStringConfig{RemovalPolicy: XIntOrStringRemovalPolicyAllow},
+			},
+		},
+		{
+			Name: "int-or-string removed, flagged even when additions are allowed",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				Type:         "integer",
+				XIntOrString: true,
+			},
+			New:     &apiextensionsv1.JSONSchemaProps{Type: "integer"},
+			Flagged: true,
+			ComparableValidation: &XIntOrString{
+				XIntOrStringConfig: XIntOrStringConfig{AdditionPolicy: XIntOrStringAdditionPolicyAllow},
+			},
+		},
+		{
+			Name: "different field changed, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				ID: "foo",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				ID: "bar",
+			},
+			Flagged:              false,
+			ComparableValidation: &XIntOrString{},
+		},
+	}
+
+	internaltesting.RunTestcases(t, testcases...)
+}
+
+func TestValidateXIntOrStringConfig(t *testing.T) {
+	testcases := []struct {
+		name               string
+		cfg                *XIntOrStringConfig
+		wantErr            error
+		wantAdditionPolicy XIntOrStringAdditionPolicy
+		wantRemovalPolicy  XIntOrStringRemovalPolicy
+	}{
+		{
+			name: "nil config",
+			cfg:  nil,
+		},
+		{
+			name:               "defaults policies",
+			cfg:                &XIntOrStringConfig{},
+			wantAdditionPolicy: XIntOrStringAdditionPolicyDisallow,
+			wantRemovalPolicy:  XIntOrStringRemovalPolicyDisallow,
+		},
+		{
+			name:               "allows valid policies",
+			cfg:                &XIntOrStringConfig{AdditionPolicy: XIntOrStringAdditionPolicyAllow, RemovalPolicy: XIntOrStringRemovalPolicyAllow},
+			wantAdditionPolicy: XIntOrStringAdditionPolicyAllow,
+			wantRemovalPolicy:  XIntOrStringRemovalPolicyAllow,
+		},
+		{
+			name:    "invalid addition policy",
+			cfg:     &XIntOrStringConfig{AdditionPolicy: "invalid"},
+			wantErr: errUnknownXIntOrStringAdditionPolicy,
+		},
+		{
+			name:    "invalid removal policy",
+			cfg:     &XIntOrStringConfig{RemovalPolicy: "invalid"},
+			wantErr: errUnknownXIntOrStringRemovalPolicy,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateXIntOrStringConfig(tc.cfg)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tc.cfg == nil {
+				return
+			}
+
+			if tc.cfg.AdditionPolicy != tc.wantAdditionPolicy {
+				t.Fatalf("expected addition policy %q, got %q", tc.wantAdditionPolicy, tc.cfg.AdditionPolicy)
+			}
+
+			if tc.cfg.RemovalPolicy != tc.wantRemovalPolicy {
+				t.Fatalf("expected removal policy %q, got %q", tc.wantRemovalPolicy, tc.cfg.RemovalPolicy)
+			}
+		})
+	}
+}
+
+func TestIntOrStringTypeChangeReportedOnce(t *testing.T) {
+	xIntOrString := &XIntOrString{}
+	xIntOrString.SetEnforcement(config.EnforcementPolicyError)
+
+	typ := &Type{}
+	typ.SetEnforcement(config.EnforcementPolicyError)
+
+	for name, comparators := range map[string][]validations.Comparator[apiextensionsv1.JSONSchemaProps]{
+		"type first":         {typ, xIntOrString},
+		"xIntOrString first": {xIntOrString, typ},
+	} {
+		t.Run(name, func(t *testing.T) {
+			results := validations.CompareProperties(
+				&apiextensionsv1.JSONSchemaProps{Type: "integer"},
+				&apiextensionsv1.JSONSchemaProps{XIntOrString: true},
+				config.EnforcementPolicyError,
+				comparators...,
+			)
+
+			errs := []string{}
+			for _, result := range results {
+				errs = append(errs, result.Errors...)
+			}
+
+			expected := []string{`type changed : "integer" -> "int-or-string"`}
+			if !slices.Equal(errs, expected) {
+				t.Fatalf("expected errors %q, got %q", expected, errs)
+			}
+		})
+	}
+}
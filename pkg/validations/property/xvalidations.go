@@ -0,0 +1,410 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"fmt"
+
+	celast "github.com/google/cel-go/common/ast"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionscel "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+	"k8s.io/apiserver/pkg/cel/environment"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/crdify/pkg/config"
+	"sigs.k8s.io/crdify/pkg/validations"
+)
+
+var (
+	_ validations.Validation                                  = (*XValidations)(nil)
+	_ validations.Comparator[apiextensionsv1.JSONSchemaProps] = (*XValidations)(nil)
+)
+
+const xValidationsValidationName = "xValidations"
+
+// RegisterXValidations registers the XValidations validation
+// with the provided validation registry.
+func RegisterXValidations(registry validations.Registry) {
+	registry.Register(xValidationsValidationName, xValidationsFactory)
+}
+
+// xValidationsFactory is a function used to initialize an XValidations validation
+// implementation based on the provided configuration.
+func xValidationsFactory(cfg map[string]interface{}) (validations.Validation, error) {
+	xValidationsCfg := &XValidationsConfig{}
+
+	err := ConfigToType(cfg, xValidationsCfg)
+	if err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	err = ValidateXValidationsConfig(xValidationsCfg)
+	if err != nil {
+		return nil, fmt.Errorf("validating xValidations config: %w", err)
+	}
+
+	return &XValidations{XValidationsConfig: *xValidationsCfg}, nil
+}
+
+// ValidateXValidationsConfig ensures provided XValidationsConfig is valid and defaults missing values.
+func ValidateXValidationsConfig(in *XValidationsConfig) error {
+	if in == nil {
+		return nil
+	}
+
+	switch in.AdditionPolicy {
+	case XValidationsAdditionPolicyAllow, XValidationsAdditionPolicyDisallow:
+		// valid entries
+	case XValidationsAdditionPolicy(""):
+		in.AdditionPolicy = XValidationsAdditionPolicyDisallow
+	default:
+		return fmt.Errorf("%w : %q (valid values: %q, %q)", errUnknownXValidationsAdditionPolicy, in.AdditionPolicy, XValidationsAdditionPolicyAllow, XValidationsAdditionPolicyDisallow)
+	}
+
+	switch in.RemovalPolicy {
+	case XValidationsRemovalPolicyAllow, XValidationsRemovalPolicyDisallow:
+		// valid entries
+	case XValidationsRemovalPolicy(""):
+		in.RemovalPolicy = XValidationsRemovalPolicyDisallow
+	default:
+		return fmt.Errorf("%w : %q (valid values: %q, %q)", errUnknownXValidationsRemovalPolicy, in.RemovalPolicy, XValidationsRemovalPolicyAllow, XValidationsRemovalPolicyDisallow)
+	}
+
+	switch in.RatchetingPolicy {
+	case XValidationsRatchetingPolicyNone, XValidationsRatchetingPolicyWarn, XValidationsRatchetingPolicyIgnore:
+		// valid entries
+	case XValidationsRatchetingPolicy(""):
+		in.RatchetingPolicy = XValidationsRatchetingPolicyNone
+	default:
+		return fmt.Errorf("%w : %q (valid values: %q, %q, %q)", errUnknownXValidationsRatchetingPolicy, in.RatchetingPolicy, XValidationsRatchetingPolicyNone, XValidationsRatchetingPolicyWarn, XValidationsRatchetingPolicyIgnore)
+	}
+
+	return nil
+}
+
+var errUnknownXValidationsAdditionPolicy = errors.New("unknown addition policy")
+var errUnknownXValidationsRemovalPolicy = errors.New("unknown removal policy")
+var errUnknownXValidationsRatchetingPolicy = errors.New("unknown ratcheting policy")
+
+// XValidationsAdditionPolicy represents how adding new validation rules should be evaluated.
+type XValidationsAdditionPolicy string
+
+const (
+	// XValidationsAdditionPolicyAllow treats adding new validation rules to a property as compatible.
+	XValidationsAdditionPolicyAllow XValidationsAdditionPolicy = "Allow"
+	// XValidationsAdditionPolicyDisallow treats adding new validation rules to a property as incompatible.
+	XValidationsAdditionPolicyDisallow XValidationsAdditionPolicy = "Disallow"
+)
+
+// XValidationsRemovalPolicy represents how removing existing validation rules should be evaluated.
+type XValidationsRemovalPolicy string
+
+const (
+	// XValidationsRemovalPolicyAllow treats removing existing validation rules from a property as compatible.
+	XValidationsRemovalPolicyAllow XValidationsRemovalPolicy = "Allow"
+	// XValidationsRemovalPolicyDisallow treats removing existing validation rules from a property as incompatible.
+	XValidationsRemovalPolicyDisallow XValidationsRemovalPolicy = "Disallow"
+)
+
+// XValidationsRatchetingPolicy represents how added or changed validation rules
+// that are made safe for existing objects by validation ratcheting should be evaluated.
+type XValidationsRatchetingPolicy string
+
+const (
+	// XValidationsRatchetingPolicyNone does not take validation ratcheting into account
+	// and reports added or changed validation rules according to the enforcement policy.
+	XValidationsRatchetingPolicyNone XValidationsRatchetingPolicy = "None"
+	// XValidationsRatchetingPolicyWarn reports added or changed validation rules that are made safe
+	// by validation ratcheting as warnings, even when the enforcement policy is Error.
+	// Nothing is reported when the enforcement policy is None.
+	XValidationsRatchetingPolicyWarn XValidationsRatchetingPolicy = "Warn"
+	// XValidationsRatchetingPolicyIgnore does not report added or changed validation rules
+	// that are made safe by validation ratcheting.
+	XValidationsRatchetingPolicyIgnore XValidationsRatchetingPolicy = "Ignore"
+)
+
+// XValidationsConfig contains additional configuration for the XValidations validation.
+type XValidationsConfig struct {
+	// AdditionPolicy dictates whether adding new validation rules to a property is compatible.
+	// Allowed values are Allow and Disallow. Defaults to Disallow.
+	AdditionPolicy XValidationsAdditionPolicy `json:"additionPolicy,omitempty"`
+	// RemovalPolicy dictates whether removing existing validation rules from a property is compatible.
+	// Allowed values are Allow and Disallow. Defaults to Disallow.
+	RemovalPolicy XValidationsRemovalPolicy `json:"removalPolicy,omitempty"`
+	// RatchetingPolicy dictates how added or changed validation rules that do not break
+	// existing objects, because of how the API server ratchets validation, are reported.
+	// Allowed values are None, Warn and Ignore. Defaults to None.
+	RatchetingPolicy XValidationsRatchetingPolicy `json:"ratchetingPolicy,omitempty"`
+}
+
+// XValidations is a Validation that can be used to identify
+// incompatible changes to the x-kubernetes-validations (CEL) rules of CRD properties.
+type XValidations struct {
+	XValidationsConfig
+	enforcement config.EnforcementPolicy
+}
+
+// Name returns the name of the XValidations validation.
+func (x *XValidations) Name() string {
+	return xValidationsValidationName
+}
+
+// SetEnforcement sets the EnforcementPolicy for the XValidations validation.
+func (x *XValidations) SetEnforcement(policy config.EnforcementPolicy) {
+	x.enforcement = policy
+}
+
+// Compare compares an old and a new JSONSchemaProps, checking for incompatible changes to the x-kubernetes-validations rules of a property.
+// Rules are matched by their rule expression first. Any remaining rules that share their message, messageExpression or fieldPath
+// are paired and are considered to be changed, while everything else is considered to be added or removed.
+// In order for callers to determine if diffs to a JSONSchemaProps have been handled by this validation
+// the JSONSchemaProps.XValidations field will be reset to 'nil' as part of this method.
+// It is highly recommended that only copies of the JSONSchemaProps to compare are provided to this method
+// to prevent unintentional modifications.
+func (x *XValidations) Compare(a, b *apiextensionsv1.JSONSchemaProps) validations.ComparisonResult {
+	errs := []error{}
+	ratcheted := []error{}
+
+	pairs, added, removed := matchValidationRules(a.XValidations, b.XValidations)
+
+	for _, pair := range pairs {
+		if pair.old.Rule != pair.new.Rule {
+			errs, ratcheted = x.appendRatcheted(errs, ratcheted, fmt.Errorf("%w : %q -> %q", ErrValidationRuleChanged, pair.old.Rule, pair.new.Rule), pair.new)
+		}
+
+		errs = append(errs, compareValidationRuleFields(pair.old, pair.new)...)
+	}
+
+	if x.AdditionPolicy != XValidationsAdditionPolicyAllow {
+		for _, rule := range added {
+			errs, ratcheted = x.appendRatcheted(errs, ratcheted, fmt.Errorf("%w : %q", ErrValidationRuleAdded, rule.Rule), rule)
+		}
+	}
+
+	if x.RemovalPolicy != XValidationsRemovalPolicyAllow {
+		for _, rule := range removed {
+			errs = append(errs, fmt.Errorf("%w : %q", ErrValidationRuleRemoved, rule.Rule))
+		}
+	}
+
+	a.XValidations = nil
+	b.XValidations = nil
+
+	if x.RatchetingPolicy == XValidationsRatchetingPolicyIgnore {
+		ratcheted = nil
+	}
+
+	return validations.HandleErrorsAndWarnings(x.Name(), x.enforcement, errs, ratcheted)
+}
+
+// appendRatcheted appends the provided error, resulting from adding or changing the provided rule,
+// to either the set of errors or the set of errors made safe by validation ratcheting.
+// The error is only considered to be made safe by validation ratcheting when the
+// ratcheting policy is not None.
+func (x *XValidations) appendRatcheted(errs, ratcheted []error, err error, rule apiextensionsv1.ValidationRule) ([]error, []error) {
+	if x.RatchetingPolicy == XValidationsRatchetingPolicyNone || x.RatchetingPolicy == "" {
+		return append(errs, err), ratcheted
+	}
+
+	reason, ok := ratchetingReason(rule)
+	if !ok {
+		return append(errs, err), ratcheted
+	}
+
+	return errs, append(ratcheted, fmt.Errorf("%w (%s)", err, reason))
+}
+
+// ratchetingReason returns why tightening validation with the provided rule
+// does not break existing objects and whether or not it is safe at all.
+//
+// Validation ratcheting only covers updates of existing objects that leave the value
+// validated by the rule unchanged. Updates that change the value, and objects that are created,
+// are validated against the rule, so a ratcheted rule is never safe for those.
+// Rules that reference oldSelf (transition rules) are additionally not evaluated on create
+// unless optionalOldSelf is set.
+// Rules that reference oldSelf and set optionalOldSelf are evaluated on create and are never
+// considered to be safe.
+// Rules that can not be parsed are never considered to be safe.
+func ratchetingReason(rule apiextensionsv1.ValidationRule) (string, bool) {
+	usesOldSelf, err := ruleUsesOldSelf(rule.Rule)
+	if err != nil {
+		return "", false
+	}
+
+	switch {
+	case usesOldSelf && !ptr.Deref(rule.OptionalOldSelf, false):
+		return "only ratcheted for existing objects when the value is unchanged, not evaluated on create", true
+	case !usesOldSelf:
+		return "only ratcheted for existing objects when the value is unchanged, still enforced on create", true
+	default:
+		return "", false
+	}
+}
+
+// ruleUsesOldSelf parses the provided CEL rule expression using the
+// same base CEL environment as the API server and returns whether or
+// not it references the oldSelf variable.
+func ruleUsesOldSelf(rule string) (bool, error) {
+	env := environment.MustBaseEnvSet(environment.DefaultCompatibilityVersion(), true).StoredExpressionsEnv()
+
+	ast, issues := env.Parse(rule)
+	if issues.Err() != nil {
+		return false, fmt.Errorf("parsing rule %q: %w", rule, issues.Err())
+	}
+
+	idents := celast.MatchDescendants(celast.NavigateAST(ast.NativeRep()), celast.KindMatcher(celast.IdentKind))
+	for _, ident := range idents {
+		if ident.AsIdent() == apiextensionscel.OldScopedVarName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// validationRulePair is a utility struct for holding
+// an old and new ValidationRule that have been matched
+// to one another.
+type validationRulePair struct {
+	old apiextensionsv1.ValidationRule
+	new apiextensionsv1.ValidationRule
+}
+
+// matchValidationRules matches the old and new ValidationRules to one another.
+// Rules with identical rule expressions are matched first. Remaining rules are then
+// matched when they share their message, messageExpression or fieldPath.
+// It returns the matched pairs, the new rules that could not be matched (added)
+// and the old rules that could not be matched (removed).
+func matchValidationRules(oldRules, newRules apiextensionsv1.ValidationRules) ([]validationRulePair, []apiextensionsv1.ValidationRule, []apiextensionsv1.ValidationRule) {
+	pairs := []validationRulePair{}
+	matchedOld := make([]bool, len(oldRules))
+	matchedNew := make([]bool, len(newRules))
+
+	for i, newRule := range newRules {
+		for j, oldRule := range oldRules {
+			if matchedOld[j] || oldRule.Rule != newRule.Rule {
+				continue
+			}
+
+			matchedOld[j] = true
+			matchedNew[i] = true
+
+			pairs = append(pairs, validationRulePair{old: oldRule, new: newRule})
+
+			break
+		}
+	}
+
+	for i, newRule := range newRules {
+		if matchedNew[i] {
+			continue
+		}
+
+		for j, oldRule := range oldRules {
+			if matchedOld[j] || !sameValidationRule(oldRule, newRule) {
+				continue
+			}
+
+			matchedOld[j] = true
+			matchedNew[i] = true
+
+			pairs = append(pairs, validationRulePair{old: oldRule, new: newRule})
+
+			break
+		}
+	}
+
+	added := []apiextensionsv1.ValidationRule{}
+
+	for i, newRule := range newRules {
+		if !matchedNew[i] {
+			added = append(added, newRule)
+		}
+	}
+
+	removed := []apiextensionsv1.ValidationRule{}
+
+	for i, oldRule := range oldRules {
+		if !matchedOld[i] {
+			removed = append(removed, oldRule)
+		}
+	}
+
+	return pairs, added, removed
+}
+
+// sameValidationRule returns whether the provided ValidationRules, with differing rule expressions,
+// identify the same validation rule because they share a non-empty message, messageExpression or fieldPath.
+func sameValidationRule(oldRule, newRule apiextensionsv1.ValidationRule) bool {
+	switch {
+	case oldRule.Message != "" && oldRule.Message == newRule.Message:
+		return true
+	case oldRule.MessageExpression != "" && oldRule.MessageExpression == newRule.MessageExpression:
+		return true
+	case oldRule.FieldPath != "" && oldRule.FieldPath == newRule.FieldPath:
+		return true
+	default:
+		return false
+	}
+}
+
+// compareValidationRuleFields compares a matched old and new ValidationRule
+// and returns an error for every field, other than the rule expression, that has changed.
+func compareValidationRuleFields(oldRule, newRule apiextensionsv1.ValidationRule) []error {
+	errs := []error{}
+
+	if oldRule.Message != newRule.Message {
+		errs = append(errs, fmt.Errorf("%w : rule %q : %q -> %q", ErrValidationRuleMessageChanged, newRule.Rule, oldRule.Message, newRule.Message))
+	}
+
+	if oldRule.MessageExpression != newRule.MessageExpression {
+		errs = append(errs, fmt.Errorf("%w : rule %q : %q -> %q", ErrValidationRuleMessageExpressionChanged, newRule.Rule, oldRule.MessageExpression, newRule.MessageExpression))
+	}
+
+	oldReason, newReason := ptr.Deref(oldRule.Reason, ""), ptr.Deref(newRule.Reason, "")
+	if oldReason != newReason {
+		errs = append(errs, fmt.Errorf("%w : rule %q : %q -> %q", ErrValidationRuleReasonChanged, newRule.Rule, oldReason, newReason))
+	}
+
+	if oldRule.FieldPath != newRule.FieldPath {
+		errs = append(errs, fmt.Errorf("%w : rule %q : %q -> %q", ErrValidationRuleFieldPathChanged, newRule.Rule, oldRule.FieldPath, newRule.FieldPath))
+	}
+
+	oldOptionalOldSelf, newOptionalOldSelf := ptr.Deref(oldRule.OptionalOldSelf, false), ptr.Deref(newRule.OptionalOldSelf, false)
+	if oldOptionalOldSelf != newOptionalOldSelf {
+		errs = append(errs, fmt.Errorf("%w : rule %q : %t -> %t", ErrValidationRuleOptionalOldSelfChanged, newRule.Rule, oldOptionalOldSelf, newOptionalOldSelf))
+	}
+
+	return errs
+}
+
+var (
+	// ErrValidationRuleAdded represents an error state where a validation rule was added to a property.
+	ErrValidationRuleAdded = errors.New("validation rule added")
+	// ErrValidationRuleRemoved represents an error state where a validation rule was removed from a property.
+	ErrValidationRuleRemoved = errors.New("validation rule removed")
+	// ErrValidationRuleChanged represents an error state where the rule expression of a validation rule changed.
+	ErrValidationRuleChanged = errors.New("validation rule changed")
+	// ErrValidationRuleMessageChanged represents an error state where the message of a validation rule changed.
+	ErrValidationRuleMessageChanged = errors.New("validation rule message changed")
+	// ErrValidationRuleMessageExpressionChanged represents an error state where the messageExpression of a validation rule changed.
+	ErrValidationRuleMessageExpressionChanged = errors.New("validation rule messageExpression changed")
+	// ErrValidationRuleReasonChanged represents an error state where the reason of a validation rule changed.
+	ErrValidationRuleReasonChanged = errors.New("validation rule reason changed")
+	// ErrValidationRuleFieldPathChanged represents an error state where the fieldPath of a validation rule changed.
+	ErrValidationRuleFieldPathChanged = errors.New("validation rule fieldPath changed")
+	// ErrValidationRuleOptionalOldSelfChanged represents an error state where the optionalOldSelf setting of a validation rule changed.
+	ErrValidationRuleOptionalOldSelfChanged = errors.New("validation rule optionalOldSelf changed")
+)
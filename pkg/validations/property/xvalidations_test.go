@@ -0,0 +1,539 @@
+// Copyright 2025 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package property
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/crdify/pkg/config"
+	internaltesting "sigs.k8s.io/crdify/pkg/validations/internal/testing"
+)
+
+func TestXValidations(t *testing.T) {
+	testcases := []internaltesting.Testcase[apiextensionsv1.JSONSchemaProps]{
+		{
+			Name: "no diff, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() > 0", Message: "must not be empty"},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() > 0", Message: "must not be empty"},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &XValidations{},
+		},
+		{
+			Name: "rules reordered, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() > 0"},
+					{Rule: "self.size() < 10"},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() < 10"},
+					{Rule: "self.size() > 0"},
+				},
+			},
+			Flagged:              false,
+			ComparableValidation: &XValidations{},
+		},
+		{
+			Name: "net new rule, flagged by default",
+			Old:  &apiextensionsv1.JSONSchemaProps{},
+			New: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() > 0"},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &XValidations{},
+		},
+		{
+			Name: "rule added, allowed via config",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() > 0"},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() > 0"},
+					{Rule: "self.size() < 10"},
+				},
+			},
+			Flagged: false,
+			ComparableValidation: &XValidations{
+				XValidationsConfig: XValidationsConfig{AdditionPolicy: XValidationsAdditionPolicyAllow},
+			},
+		},
+		{
+			Name: "rule removed, flagged by default",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() > 0"},
+					{Rule: "self.size() < 10"},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() > 0"},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &XValidations{},
+		},
+		{
+			Name: "rule removed, allowed via config",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() > 0"},
+				},
+			},
+			New:     &apiextensionsv1.JSONSchemaProps{},
+			Flagged: false,
+			ComparableValidation: &XValidations{
+				XValidationsConfig: XValidationsConfig{RemovalPolicy: XValidationsRemovalPolicyAllow},
+			},
+		},
+		{
+			Name: "rule expression changed, flagged even when additions and removals are allowed",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() > 0", Message: "must not be empty"},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() > 1", Message: "must not be empty"},
+				},
+			},
+			Flagged: true,
+			ComparableValidation: &XValidations{
+				XValidationsConfig: XValidationsConfig{
+					AdditionPolicy: XValidationsAdditionPolicyAllow,
+					RemovalPolicy:  XValidationsRemovalPolicyAllow,
+				},
+			},
+		},
+		{
+			Name: "rule expression changed without a shared message, treated as removed and added, allowed via config",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() > 0", Message: "must not be empty"},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() > 1", Message: "must have more than one entry"},
+				},
+			},
+			Flagged: false,
+			ComparableValidation: &XValidations{
+				XValidationsConfig: XValidationsConfig{
+					AdditionPolicy: XValidationsAdditionPolicyAllow,
+					RemovalPolicy:  XValidationsRemovalPolicyAllow,
+				},
+			},
+		},
+		{
+			Name: "message changed, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() > 0", Message: "must not be empty"},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() > 0", Message: "must have at least one entry"},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &XValidations{},
+		},
+		{
+			Name: "messageExpression changed, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() > 0", MessageExpression: "'size is ' + string(self.size())"},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() > 0"},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &XValidations{},
+		},
+		{
+			Name: "reason changed, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() > 0"},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() > 0", Reason: ptr.To(apiextensionsv1.FieldValueForbidden)},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &XValidations{},
+		},
+		{
+			Name: "fieldPath changed, flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.foo.size() > 0", FieldPath: ".foo"},
+				},
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.foo.size() > 0", FieldPath: ".bar"},
+				},
+			},
+			Flagged:              true,
+			ComparableValidation: &XValidations{},
+		},
+		{
+			Name: "different field changed, not flagged",
+			Old: &apiextensionsv1.JSONSchemaProps{
+				ID: "foo",
+			},
+			New: &apiextensionsv1.JSONSchemaProps{
+				ID: "bar",
+			},
+			Flagged:              false,
+			ComparableValidation: &XValidations{},
+		},
+	}
+
+	internaltesting.RunTestcases(t, testcases...)
+}
+
+func TestMatchValidationRules(t *testing.T) {
+	oldRules := apiextensionsv1.ValidationRules{
+		{Rule: "a"},
+		{Rule: "b"},
+		{Rule: "c", Message: "must be c"},
+		{Rule: "e", FieldPath: ".e"},
+		{Rule: "f"},
+	}
+	newRules := apiextensionsv1.ValidationRules{
+		{Rule: "b"},
+		{Rule: "z", Message: "must be c"},
+		{Rule: "a"},
+		{Rule: "y", FieldPath: ".e"},
+		{Rule: "d"},
+	}
+
+	pairs, added, removed := matchValidationRules(oldRules, newRules)
+
+	if len(pairs) != 4 {
+		t.Fatalf("expected 4 matched pairs, got %d : %v", len(pairs), pairs)
+	}
+
+	if len(added) != 1 || added[0].Rule != "d" {
+		t.Fatalf("expected rule %q to be added, got %v", "d", added)
+	}
+
+	if len(removed) != 1 || removed[0].Rule != "f" {
+		t.Fatalf("expected rule %q to be removed, got %v", "f", removed)
+	}
+
+	changed := map[string]string{}
+
+	for _, pair := range pairs {
+		if pair.old.Rule != pair.new.Rule {
+			changed[pair.old.Rule] = pair.new.Rule
+		}
+	}
+
+	if len(changed) != 2 || changed["c"] != "z" || changed["e"] != "y" {
+		t.Fatalf("expected rules %q and %q to be changed, got %v", "c", "e", changed)
+	}
+}
+
+func TestXValidationsRatcheting(t *testing.T) {
+	testcases := []struct {
+		name         string
+		old          *apiextensionsv1.JSONSchemaProps
+		new          *apiextensionsv1.JSONSchemaProps
+		policy       XValidationsRatchetingPolicy
+		wantErrors   int
+		wantWarnings int
+	}{
+		{
+			name: "transition rule added, no ratcheting, error",
+			old:  &apiextensionsv1.JSONSchemaProps{},
+			new: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self == oldSelf"},
+				},
+			},
+			policy:     XValidationsRatchetingPolicyNone,
+			wantErrors: 1,
+		},
+		{
+			name: "transition rule added, ratcheting warn, warning",
+			old:  &apiextensionsv1.JSONSchemaProps{},
+			new: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self == oldSelf"},
+				},
+			},
+			policy:       XValidationsRatchetingPolicyWarn,
+			wantWarnings: 1,
+		},
+		{
+			name: "tightened rule, ratcheting warn, warning",
+			old: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() > 0", Message: "must not be empty"},
+				},
+			},
+			new: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self.size() > 1", Message: "must not be empty"},
+				},
+			},
+			policy:       XValidationsRatchetingPolicyWarn,
+			wantWarnings: 1,
+		},
+		{
+			name: "optionalOldSelf transition rule added, ratcheting warn, error",
+			old:  &apiextensionsv1.JSONSchemaProps{},
+			new: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "!oldSelf.hasValue() || self >= oldSelf.value()", OptionalOldSelf: ptr.To(true)},
+				},
+			},
+			policy:     XValidationsRatchetingPolicyWarn,
+			wantErrors: 1,
+		},
+		{
+			name: "unparseable rule added, ratcheting warn, error",
+			old:  &apiextensionsv1.JSONSchemaProps{},
+			new: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self >="},
+				},
+			},
+			policy:     XValidationsRatchetingPolicyWarn,
+			wantErrors: 1,
+		},
+		{
+			name: "rule removed, ratcheting warn, error",
+			old: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self == oldSelf"},
+				},
+			},
+			new:        &apiextensionsv1.JSONSchemaProps{},
+			policy:     XValidationsRatchetingPolicyWarn,
+			wantErrors: 1,
+		},
+		{
+			name: "message changed on transition rule, ratcheting ignore, error",
+			old: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self == oldSelf", Message: "foo"},
+				},
+			},
+			new: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self == oldSelf", Message: "bar"},
+				},
+			},
+			policy:     XValidationsRatchetingPolicyIgnore,
+			wantErrors: 1,
+		},
+		{
+			name: "transition rule added, ratcheting ignore, nothing",
+			old:  &apiextensionsv1.JSONSchemaProps{},
+			new: &apiextensionsv1.JSONSchemaProps{
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self == oldSelf"},
+				},
+			},
+			policy: XValidationsRatchetingPolicyIgnore,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			val := &XValidations{XValidationsConfig: XValidationsConfig{RatchetingPolicy: tc.policy}}
+			val.SetEnforcement(config.EnforcementPolicyError)
+
+			result := val.Compare(tc.old.DeepCopy(), tc.new.DeepCopy())
+
+			if len(result.Errors) != tc.wantErrors {
+				t.Fatalf("expected %d errors, got %v", tc.wantErrors, result.Errors)
+			}
+
+			if len(result.Warnings) != tc.wantWarnings {
+				t.Fatalf("expected %d warnings, got %v", tc.wantWarnings, result.Warnings)
+			}
+		})
+	}
+}
+
+func TestXValidationsRatchetingReportsLimitation(t *testing.T) {
+	val := &XValidations{XValidationsConfig: XValidationsConfig{RatchetingPolicy: XValidationsRatchetingPolicyWarn}}
+	val.SetEnforcement(config.EnforcementPolicyError)
+
+	result := val.Compare(
+		&apiextensionsv1.JSONSchemaProps{},
+		&apiextensionsv1.JSONSchemaProps{
+			XValidations: apiextensionsv1.ValidationRules{
+				{Rule: "self.size() > 0"},
+				{Rule: "self == oldSelf"},
+			},
+		},
+	)
+
+	expected := []string{
+		`validation rule added : "self.size() > 0" (only ratcheted for existing objects when the value is unchanged, still enforced on create)`,
+		`validation rule added : "self == oldSelf" (only ratcheted for existing objects when the value is unchanged, not evaluated on create)`,
+	}
+
+	if !slices.Equal(result.Warnings, expected) {
+		t.Fatalf("expected warnings %q, got %q", expected, result.Warnings)
+	}
+}
+
+func TestRuleUsesOldSelf(t *testing.T) {
+	testcases := []struct {
+		rule    string
+		want    bool
+		wantErr bool
+	}{
+		{rule: "self.size() > 0", want: false},
+		{rule: "self == oldSelf", want: true},
+		{rule: "self.oldSelf == 'foo'", want: false},
+		{rule: "!oldSelf.hasValue() || self >= oldSelf.value()", want: true},
+		{rule: "self.?foo.orValue('') == oldSelf.?foo.orValue('')", want: true},
+		{rule: "self >=", wantErr: true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.rule, func(t *testing.T) {
+			got, err := ruleUsesOldSelf(tc.rule)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tc.want {
+				t.Fatalf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateXValidationsConfig(t *testing.T) {
+	testcases := []struct {
+		name               string
+		cfg                *XValidationsConfig
+		wantErr            error
+		wantAdditionPolicy XValidationsAdditionPolicy
+		wantRemovalPolicy  XValidationsRemovalPolicy
+		wantRatcheting     XValidationsRatchetingPolicy
+	}{
+		{
+			name: "nil config",
+			cfg:  nil,
+		},
+		{
+			name:               "defaults policies",
+			cfg:                &XValidationsConfig{},
+			wantAdditionPolicy: XValidationsAdditionPolicyDisallow,
+			wantRemovalPolicy:  XValidationsRemovalPolicyDisallow,
+			wantRatcheting:     XValidationsRatchetingPolicyNone,
+		},
+		{
+			name:               "allows valid policies",
+			cfg:                &XValidationsConfig{AdditionPolicy: XValidationsAdditionPolicyAllow, RemovalPolicy: XValidationsRemovalPolicyAllow, RatchetingPolicy: XValidationsRatchetingPolicyWarn},
+			wantAdditionPolicy: XValidationsAdditionPolicyAllow,
+			wantRemovalPolicy:  XValidationsRemovalPolicyAllow,
+			wantRatcheting:     XValidationsRatchetingPolicyWarn,
+		},
+		{
+			name:    "invalid addition policy",
+			cfg:     &XValidationsConfig{AdditionPolicy: "invalid"},
+			wantErr: errUnknownXValidationsAdditionPolicy,
+		},
+		{
+			name:    "invalid removal policy",
+			cfg:     &XValidationsConfig{RemovalPolicy: "invalid"},
+			wantErr: errUnknownXValidationsRemovalPolicy,
+		},
+		{
+			name:    "invalid ratcheting policy",
+			cfg:     &XValidationsConfig{RatchetingPolicy: "invalid"},
+			wantErr: errUnknownXValidationsRatchetingPolicy,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateXValidationsConfig(tc.cfg)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tc.cfg == nil {
+				return
+			}
+
+			if tc.cfg.AdditionPolicy != tc.wantAdditionPolicy {
+				t.Fatalf("expected addition policy %q, got %q", tc.wantAdditionPolicy, tc.cfg.AdditionPolicy)
+			}
+
+			if tc.cfg.RemovalPolicy != tc.wantRemovalPolicy {
+				t.Fatalf("expected removal policy %q, got %q", tc.wantRemovalPolicy, tc.cfg.RemovalPolicy)
+			}
+
+			if tc.cfg.RatchetingPolicy != tc.wantRatcheting {
+				t.Fatalf("expected ratcheting policy %q, got %q", tc.wantRatcheting, tc.cfg.RatchetingPolicy)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ package validations
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"sync"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -43,14 +44,29 @@ func GetCRDVersionByName(crd *apiextensionsv1.CustomResourceDefinition, name str
 
 // FlattenCRDVersion flattens the provided CustomResourceDefinition into a mapping of
 // property path (i.e ^.spec.foo.bar) to its JSONSchemaProps.
+// The branches of allOf, anyOf, oneOf and not, and their children, are not flattened
+// as they are only identified by their index. The schemas of pattern properties, and their children,
+// are not flattened as they are identified by a pattern that may be rewritten.
+// Pattern properties that exist under the same pattern in two flattened versions can be found
+// with SharedPatternProperties and flattened with FlattenSchema.
 func FlattenCRDVersion(crdVersion apiextensionsv1.CustomResourceDefinitionVersion) map[string]*apiextensionsv1.JSONSchemaProps {
+	return FlattenSchema(crdVersion.Schema.OpenAPIV3Schema, field.NewPath("^"))
+}
+
+// FlattenSchema flattens the provided schema into a mapping of property path to its JSONSchemaProps
+// in the same way as FlattenCRDVersion, where the path of the provided schema is root.
+func FlattenSchema(schema *apiextensionsv1.JSONSchemaProps, root *field.Path) map[string]*apiextensionsv1.JSONSchemaProps {
 	flatMap := map[string]*apiextensionsv1.JSONSchemaProps{}
 
-	SchemaHas(crdVersion.Schema.OpenAPIV3Schema,
-		field.NewPath("^"),
+	SchemaHas(schema,
 		field.NewPath("^"),
+		root,
 		nil,
-		func(s *apiextensionsv1.JSONSchemaProps, _, simpleLocation *field.Path, _ []*apiextensionsv1.JSONSchemaProps) bool {
+		func(s *apiextensionsv1.JSONSchemaProps, fldPath, simpleLocation *field.Path, _ []*apiextensionsv1.JSONSchemaProps) bool {
+			if InCompositionBranch(fldPath) || InPatternProperty(fldPath) {
+				return false
+			}
+
 			flatMap[simpleLocation.String()] = s.DeepCopy()
 			return false
 		},
@@ -59,6 +75,52 @@ func FlattenCRDVersion(crdVersion apiextensionsv1.CustomResourceDefinitionVersio
 	return flatMap
 }
 
+// SharedPatternProperties returns the schemas of the pattern properties that exist under the same pattern
+// in both the old and the new schema of a property that exists in both of the provided flattened schemas.
+// The returned map is keyed by the path of the pattern property (i.e ^.spec.patternProperties[^a+$]).
+// The schemas are provided as they are, so they can be flattened to evaluate the properties they contain.
+func SharedPatternProperties(a, b map[string]*apiextensionsv1.JSONSchemaProps) map[string]Diff {
+	shared := map[string]Diff{}
+
+	for property, oldSchema := range a {
+		newSchema, ok := b[property]
+		if !ok {
+			continue
+		}
+
+		for pattern, oldPatternSchema := range oldSchema.PatternProperties {
+			newPatternSchema, ok := newSchema.PatternProperties[pattern]
+			if !ok {
+				continue
+			}
+
+			shared[field.NewPath(property).Child("patternProperties").Key(pattern).String()] = Diff{Old: &oldPatternSchema, New: &newPatternSchema}
+		}
+	}
+
+	return shared
+}
+
+// compositionBranchRegexp matches the segments of a field path
+// that descend into an allOf, anyOf, oneOf or not branch.
+var compositionBranchRegexp = regexp.MustCompile(`\.(allOf|anyOf|oneOf)\[\d+\]|\.not(\.|\[|$)`)
+
+// InCompositionBranch returns whether the provided field path, as provided to a SchemaWalkerFunc,
+// points to a branch of an allOf, anyOf, oneOf or not, or to one of the children of such a branch.
+func InCompositionBranch(fldPath *field.Path) bool {
+	return compositionBranchRegexp.MatchString(fldPath.String())
+}
+
+// patternPropertyRegexp matches the segments of a field path
+// that descend into the schema of a pattern property.
+var patternPropertyRegexp = regexp.MustCompile(`\.patternProperties\[`)
+
+// InPatternProperty returns whether the provided field path, as provided to a SchemaWalkerFunc,
+// points to the schema of a pattern property, or to one of the children of such a schema.
+func InPatternProperty(fldPath *field.Path) bool {
+	return patternPropertyRegexp.MatchString(fldPath.String())
+}
+
 // Diff is a utility struct for holding an old and new JSONSchemaProps.
 type Diff struct {
 	Old *apiextensionsv1.JSONSchemaProps
@@ -105,12 +167,35 @@ func FlattenedCRDVersionDiff(a, b map[string]*apiextensionsv1.JSONSchemaProps) m
 // differences between a before and after of a given schema
 // without the changes to its children schemas influencing the
 // diff calculation.
+// The additionalProperties and additionalItems schemas are replaced with empty schemas so that
+// only transitions between `true`, `false` and a schema are considered.
+// Tuple items are replaced with the same number of empty schemas so that only the number of
+// tuple items is considered, and the schemas of pattern properties with empty schemas so that
+// only their patterns are considered.
 // Returns a copy of the provided apiextensionsv1.JSONSchemaProps with children schemas dropped.
 func DropChildrenPropertiesFromJSONSchema(schema *apiextensionsv1.JSONSchemaProps) *apiextensionsv1.JSONSchemaProps {
 	schemaCopy := schema.DeepCopy()
 	schemaCopy.Properties = nil
 	schemaCopy.Items = nil
 
+	if schema.Items != nil && len(schema.Items.JSONSchemas) > 0 {
+		schemaCopy.Items = &apiextensionsv1.JSONSchemaPropsOrArray{
+			JSONSchemas: make([]apiextensionsv1.JSONSchemaProps, len(schema.Items.JSONSchemas)),
+		}
+	}
+
+	if schemaCopy.AdditionalProperties != nil && schemaCopy.AdditionalProperties.Schema != nil {
+		schemaCopy.AdditionalProperties.Schema = &apiextensionsv1.JSONSchemaProps{}
+	}
+
+	if schemaCopy.AdditionalItems != nil && schemaCopy.AdditionalItems.Schema != nil {
+		schemaCopy.AdditionalItems.Schema = &apiextensionsv1.JSONSchemaProps{}
+	}
+
+	for pattern := range schemaCopy.PatternProperties {
+		schemaCopy.PatternProperties[pattern] = apiextensionsv1.JSONSchemaProps{}
+	}
+
 	return schemaCopy
 }
 
@@ -324,3 +409,20 @@ func HandleErrors(name string, policy config.EnforcementPolicy, errs ...error) C
 
 	return result
 }
+
+// HandleErrorsAndWarnings is a utility function for Comparators to generate a ComparisonResult
+// when some of the issues they encountered should never be reported with a higher severity than a warning.
+// errs are handled in the same way as HandleErrors. warns are reported as warnings when the enforcement
+// policy is Error or Warn and are not reported when the enforcement policy is None.
+func HandleErrorsAndWarnings(name string, policy config.EnforcementPolicy, errs []error, warns []error) ComparisonResult {
+	result := HandleErrors(name, policy, errs...)
+
+	warnPolicy := policy
+	if warnPolicy == config.EnforcementPolicyError {
+		warnPolicy = config.EnforcementPolicyWarn
+	}
+
+	result.Warnings = append(result.Warnings, HandleErrors(name, warnPolicy, warns...).Warnings...)
+
+	return result
+}
@@ -15,12 +15,15 @@
 package validations
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/crdify/pkg/config"
 )
 
 func TestFlattenedCRDVersionDiff(t *testing.T) {
@@ -120,6 +123,149 @@ func TestFlattenedCRDVersionDiff(t *testing.T) {
 				Required: []string{"bar"},
 			},
 		},
+		{
+			name: "additionalProperties schema to false",
+			old: apiextensionsv1.CustomResourceDefinitionVersion{
+				Name:    "v1alpha1",
+				Served:  true,
+				Storage: true,
+				Schema: &apiextensionsv1.CustomResourceValidation{
+					OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+						AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{
+							Allows: true,
+							Schema: &apiextensionsv1.JSONSchemaProps{
+								Type: "string",
+							},
+						},
+					},
+				},
+			},
+			new: apiextensionsv1.CustomResourceDefinitionVersion{
+				Name:    "v1alpha1",
+				Served:  true,
+				Storage: true,
+				Schema: &apiextensionsv1.CustomResourceValidation{
+					OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+						AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{
+							Allows: false,
+						},
+					},
+				},
+			},
+			diffKey: "^",
+			// The additionalProperties schema is evaluated as a property of its own,
+			// so only the transition from a schema to false remains.
+			oldDiff: apiextensionsv1.JSONSchemaProps{
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{
+					Allows: true,
+					Schema: &apiextensionsv1.JSONSchemaProps{},
+				},
+			},
+			newDiff: apiextensionsv1.JSONSchemaProps{
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{
+					Allows: false,
+				},
+			},
+		},
+		{
+			name: "tuple item added",
+			old: apiextensionsv1.CustomResourceDefinitionVersion{
+				Name:    "v1alpha1",
+				Served:  true,
+				Storage: true,
+				Schema: &apiextensionsv1.CustomResourceValidation{
+					OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+						Type: "array",
+						Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+							JSONSchemas: []apiextensionsv1.JSONSchemaProps{
+								{
+									Type: "string",
+								},
+							},
+						},
+					},
+				},
+			},
+			new: apiextensionsv1.CustomResourceDefinitionVersion{
+				Name:    "v1alpha1",
+				Served:  true,
+				Storage: true,
+				Schema: &apiextensionsv1.CustomResourceValidation{
+					OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+						Type: "array",
+						Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+							JSONSchemas: []apiextensionsv1.JSONSchemaProps{
+								{
+									Type: "string",
+								},
+								{
+									Type: "integer",
+								},
+							},
+						},
+					},
+				},
+			},
+			diffKey: "^",
+			// The tuple items are evaluated as properties of their own,
+			// so only the number of tuple items remains.
+			oldDiff: apiextensionsv1.JSONSchemaProps{
+				Type: "array",
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+					JSONSchemas: []apiextensionsv1.JSONSchemaProps{{}},
+				},
+			},
+			newDiff: apiextensionsv1.JSONSchemaProps{
+				Type: "array",
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+					JSONSchemas: []apiextensionsv1.JSONSchemaProps{{}, {}},
+				},
+			},
+		},
+		{
+			name: "pattern property renamed",
+			old: apiextensionsv1.CustomResourceDefinitionVersion{
+				Name:    "v1alpha1",
+				Served:  true,
+				Storage: true,
+				Schema: &apiextensionsv1.CustomResourceValidation{
+					OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+						PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{
+							"^a+$": {
+								Type: "string",
+							},
+						},
+					},
+				},
+			},
+			new: apiextensionsv1.CustomResourceDefinitionVersion{
+				Name:    "v1alpha1",
+				Served:  true,
+				Storage: true,
+				Schema: &apiextensionsv1.CustomResourceValidation{
+					OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+						PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{
+							"^(a)+$": {
+								Type: "string",
+							},
+						},
+					},
+				},
+			},
+			diffKey: "^",
+			// The schemas of pattern properties are not flattened, so renaming a pattern
+			// is only a diff of the patterns of the property it belongs to.
+			oldDiff: apiextensionsv1.JSONSchemaProps{
+				PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{
+					"^a+$": {},
+				},
+			},
+			newDiff: apiextensionsv1.JSONSchemaProps{
+				PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{
+					"^(a)+$": {},
+				},
+			},
+		},
 		{
 			name: "no change",
 			old: apiextensionsv1.CustomResourceDefinitionVersion{
@@ -293,7 +439,7 @@ func TestFlattenCRDVersion(t *testing.T) {
 			},
 		},
 		{
-			name: "schema with allOf",
+			name: "schema with allOf, branches are not flattened",
 			version: apiextensionsv1.CustomResourceDefinitionVersion{
 				Schema: &apiextensionsv1.CustomResourceValidation{
 					OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
@@ -321,14 +467,12 @@ func TestFlattenCRDVersion(t *testing.T) {
 			},
 			expectedKeys: []string{
 				"^.spec.fieldOne",
-				"^.spec.fieldOne.allOf[0]",
-				"^.spec.fieldOne.allOf[0].nested",
 				"^.spec",
 				"^",
 			},
 		},
 		{
-			name: "schema with anyOf",
+			name: "schema with anyOf, branches are not flattened",
 			version: apiextensionsv1.CustomResourceDefinitionVersion{
 				Schema: &apiextensionsv1.CustomResourceValidation{
 					OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
@@ -356,14 +500,12 @@ func TestFlattenCRDVersion(t *testing.T) {
 			},
 			expectedKeys: []string{
 				"^.spec.fieldOne",
-				"^.spec.fieldOne.anyOf[0]",
-				"^.spec.fieldOne.anyOf[0].nested",
 				"^.spec",
 				"^",
 			},
 		},
 		{
-			name: "schema with oneOf",
+			name: "schema with oneOf, branches are not flattened",
 			version: apiextensionsv1.CustomResourceDefinitionVersion{
 				Schema: &apiextensionsv1.CustomResourceValidation{
 					OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
@@ -391,14 +533,12 @@ func TestFlattenCRDVersion(t *testing.T) {
 			},
 			expectedKeys: []string{
 				"^.spec.fieldOne",
-				"^.spec.fieldOne.oneOf[0]",
-				"^.spec.fieldOne.oneOf[0].nested",
 				"^.spec",
 				"^",
 			},
 		},
 		{
-			name: "schema with not",
+			name: "schema with not, branches are not flattened",
 			version: apiextensionsv1.CustomResourceDefinitionVersion{
 				Schema: &apiextensionsv1.CustomResourceValidation{
 					OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
@@ -424,8 +564,6 @@ func TestFlattenCRDVersion(t *testing.T) {
 			},
 			expectedKeys: []string{
 				"^.spec.fieldOne",
-				"^.spec.fieldOne.not",
-				"^.spec.fieldOne.not.nested",
 				"^.spec",
 				"^",
 			},
@@ -495,8 +633,6 @@ func TestFlattenCRDVersion(t *testing.T) {
 			},
 			expectedKeys: []string{
 				"^.spec.fieldOne",
-				"^.spec.fieldOne.patternProperties[pattern]",
-				"^.spec.fieldOne.patternProperties[pattern].nested",
 				"^.spec",
 				"^",
 			},
@@ -628,3 +764,113 @@ func TestFlattenCRDVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestInCompositionBranch(t *testing.T) {
+	root := field.NewPath("^").Child("properties").Key("spec")
+
+	for _, tc := range []struct {
+		name     string
+		path     *field.Path
+		expected bool
+	}{
+		{name: "property", path: root.Child("properties").Key("foo"), expected: false},
+		{name: "property named like a composition", path: root.Child("properties").Key("not"), expected: false},
+		{name: "allOf branch", path: root.Child("allOf").Index(0), expected: true},
+		{name: "anyOf branch", path: root.Child("anyOf").Index(1), expected: true},
+		{name: "oneOf branch", path: root.Child("oneOf").Index(2), expected: true},
+		{name: "not", path: root.Child("not"), expected: true},
+		{name: "child of a not", path: root.Child("not").Child("properties").Key("foo"), expected: true},
+		{name: "child of an allOf branch", path: root.Child("allOf").Index(0).Child("properties").Key("foo"), expected: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, InCompositionBranch(tc.path))
+		})
+	}
+}
+
+func TestInPatternProperty(t *testing.T) {
+	root := field.NewPath("^").Child("properties").Key("spec")
+
+	for _, tc := range []struct {
+		name     string
+		path     *field.Path
+		expected bool
+	}{
+		{name: "property", path: root.Child("properties").Key("foo"), expected: false},
+		{name: "property named like patternProperties", path: root.Child("properties").Key("patternProperties"), expected: false},
+		{name: "pattern property", path: root.Child("patternProperties").Key("^a+$"), expected: true},
+		{name: "child of a pattern property", path: root.Child("patternProperties").Key("^a+$").Child("properties").Key("foo"), expected: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, InPatternProperty(tc.path))
+		})
+	}
+}
+
+func TestSharedPatternProperties(t *testing.T) {
+	oldFlattened := map[string]*apiextensionsv1.JSONSchemaProps{
+		"^.spec": {
+			PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{
+				"^a+$": {Type: "string"},
+				"^b+$": {Type: "string"},
+			},
+		},
+		"^.status": {
+			PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{
+				"^a+$": {Type: "string"},
+			},
+		},
+	}
+	newFlattened := map[string]*apiextensionsv1.JSONSchemaProps{
+		"^.spec": {
+			PatternProperties: map[string]apiextensionsv1.JSONSchemaProps{
+				"^a+$":   {Type: "integer"},
+				"^(b)+$": {Type: "string"},
+			},
+		},
+	}
+
+	shared := SharedPatternProperties(oldFlattened, newFlattened)
+
+	require.Len(t, shared, 1)
+	require.Equal(t, "string", shared["^.spec.patternProperties[^a+$]"].Old.Type)
+	require.Equal(t, "integer", shared["^.spec.patternProperties[^a+$]"].New.Type)
+}
+
+func TestHandleErrorsAndWarnings(t *testing.T) {
+	type testcase struct {
+		name             string
+		policy           config.EnforcementPolicy
+		expectedErrors   []string
+		expectedWarnings []string
+	}
+
+	errs := []error{errors.New("foo")}
+	warns := []error{errors.New("bar")}
+
+	for _, tc := range []testcase{
+		{
+			name:             "error policy, warnings stay warnings",
+			policy:           config.EnforcementPolicyError,
+			expectedErrors:   []string{"foo"},
+			expectedWarnings: []string{"bar"},
+		},
+		{
+			name:             "warn policy, everything is a warning",
+			policy:           config.EnforcementPolicyWarn,
+			expectedWarnings: []string{"foo", "bar"},
+		},
+		{
+			name:   "none policy, nothing reported",
+			policy: config.EnforcementPolicyNone,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			result := HandleErrorsAndWarnings("test", tc.policy, errs, warns)
+
+			require.Equal(t, "test", result.Name)
+			require.Equal(t, tc.expectedErrors, result.Errors)
+			require.Equal(t, tc.expectedWarnings, result.Warnings)
+		})
+	}
+}
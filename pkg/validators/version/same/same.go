@@ -74,8 +74,8 @@ func (v *Validator) Validate(a, b *apiextensionsv1.CustomResourceDefinition) []v
 		// of an existing version is a breaking change. It may be considered safe
 		// if there are no CRs stored at that version or migration has successfully
 		// occurred. Since the safety of this varies and we don't have explicit
-		// knowledge of this we assume a separate check will be in place to capture
-		// this as a breaking change.
+		// knowledge of this the removal is captured by the storedVersionRemoval
+		// and versionRemoval validations instead.
 		if newVersion == nil {
 			continue
 		}